@@ -0,0 +1,5 @@
+data "goodaccess_access_cards" "platform" {
+  tags = {
+    owner = "platform-team"
+  }
+}
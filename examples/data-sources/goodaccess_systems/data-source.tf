@@ -0,0 +1,12 @@
+data "goodaccess_systems" "production" {
+  tags = {
+    environment = "production"
+  }
+}
+
+resource "goodaccess_access_card_system" "production" {
+  for_each = toset(data.goodaccess_systems.production.ids)
+
+  access_card_id = goodaccess_access_card.example.id
+  system_id      = each.value
+}
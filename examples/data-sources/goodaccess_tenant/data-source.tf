@@ -0,0 +1,5 @@
+data "goodaccess_tenant" "current" {}
+
+output "tenant" {
+  value = data.goodaccess_tenant.current.name
+}
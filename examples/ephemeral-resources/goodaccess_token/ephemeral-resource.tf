@@ -0,0 +1,14 @@
+# A provider instance without a token is enough to obtain one.
+provider "goodaccess" {
+  alias = "auth"
+}
+
+ephemeral "goodaccess_token" "this" {
+  provider      = goodaccess.auth
+  client_id     = var.goodaccess_client_id
+  client_secret = var.goodaccess_client_secret
+}
+
+provider "goodaccess" {
+  token = ephemeral.goodaccess_token.this.access_token
+}
@@ -0,0 +1,4 @@
+# Returns "*.xn--bcher-kva.example"
+output "host" {
+  value = provider::goodaccess::normalize_host("*.Bücher.example.")
+}
@@ -0,0 +1,7 @@
+locals {
+  relation = provider::goodaccess::parse_relation_id(goodaccess_access_card_system.example.id)
+}
+
+output "system_id" {
+  value = local.relation.system_id
+}
@@ -0,0 +1,3 @@
+output "relation_id" {
+  value = provider::goodaccess::relation_id(goodaccess_access_card.example.id, goodaccess_system.example.id)
+}
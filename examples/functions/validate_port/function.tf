@@ -0,0 +1,8 @@
+variable "port" {
+  type = string
+
+  validation {
+    condition     = provider::goodaccess::validate_port(var.port)
+    error_message = "port must be a port between 1 and 65535 or a range such as 8000-8100."
+  }
+}
@@ -0,0 +1,4 @@
+list "goodaccess_access_card" "all" {
+  provider         = goodaccess
+  include_resource = true
+}
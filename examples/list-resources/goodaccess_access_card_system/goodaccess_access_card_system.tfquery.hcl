@@ -0,0 +1,7 @@
+list "goodaccess_access_card_system" "developers" {
+  provider = goodaccess
+
+  config {
+    access_card_id = "3f6c1d2e-0a4b-4c5d-9e8f-7a6b5c4d3e2f"
+  }
+}
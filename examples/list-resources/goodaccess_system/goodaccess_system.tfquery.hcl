@@ -0,0 +1,10 @@
+list "goodaccess_system" "production" {
+  provider         = goodaccess
+  include_resource = true
+
+  config {
+    tags = {
+      environment = "production"
+    }
+  }
+}
@@ -1,3 +1,14 @@
 provider "goodaccess" {
   token = "XXX"
-}
\ No newline at end of file
+
+  managed_by = "github.com/example/infrastructure"
+  default_tags = {
+    environment = "production"
+  }
+}
+# Alternatively, authenticate with OAuth2 client credentials.
+provider "goodaccess" {
+  alias         = "oauth"
+  client_id     = var.goodaccess_client_id
+  client_secret = var.goodaccess_client_secret
+}
@@ -0,0 +1,6 @@
+import {
+  to = goodaccess_access_card.example
+  identity = {
+    id = "456"
+  }
+}
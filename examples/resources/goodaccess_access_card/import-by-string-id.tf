@@ -0,0 +1,4 @@
+import {
+  to = goodaccess_access_card.example
+  id = "456"
+}
@@ -0,0 +1 @@
+terraform import goodaccess_access_card.example 456
@@ -3,3 +3,11 @@ resource "goodaccess_access_card" "example" {
   name        = "Access Card from TF"
   description = "Managed by Terraform"
 }
+
+resource "goodaccess_access_card" "developers" {
+  name = "Developers"
+
+  system_ids = [
+    goodaccess_system.example.id,
+  ]
+}
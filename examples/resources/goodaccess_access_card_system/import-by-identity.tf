@@ -0,0 +1,7 @@
+import {
+  to = goodaccess_access_card_system.example
+  identity = {
+    access_card_id = "456"
+    system_id      = "123"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = goodaccess_access_card_system.example
+  id = "456:123"
+}
@@ -0,0 +1 @@
+terraform import goodaccess_access_card_system.example 456:123
@@ -0,0 +1,5 @@
+# Rename an existing goodaccess_relation_ac_s without recreating the relation.
+moved {
+  from = goodaccess_relation_ac_s.example
+  to   = goodaccess_access_card_system.example
+}
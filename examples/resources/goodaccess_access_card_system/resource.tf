@@ -0,0 +1,16 @@
+
+resource "goodaccess_access_card_system" "example" {
+  access_card_id = goodaccess_access_card.example.id
+  system_id      = goodaccess_system.example.id
+}
+
+resource "goodaccess_access_card_system" "by_name" {
+  access_card_name = "Developers"
+  system_name      = "GitLab"
+}
+
+resource "goodaccess_access_card_system" "contractor" {
+  access_card_id = goodaccess_access_card.contractors.id
+  system_id      = goodaccess_system.example.id
+  expires_at     = "2026-12-31T23:59:59Z"
+}
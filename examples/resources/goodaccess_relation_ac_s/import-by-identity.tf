@@ -0,0 +1,7 @@
+import {
+  to = goodaccess_relation_ac_s.example
+  identity = {
+    access_card_id = "456"
+    system_id      = "123"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = goodaccess_relation_ac_s.example
+  id = "456:123"
+}
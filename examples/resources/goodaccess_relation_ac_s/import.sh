@@ -0,0 +1 @@
+terraform import goodaccess_relation_ac_s.example 456:123
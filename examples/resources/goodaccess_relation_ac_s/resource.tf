@@ -2,4 +2,15 @@
 resource "goodaccess_relation_ac_s" "example" {
   access_card_id = goodaccess_access_card.example.id
   system_id      = goodaccess_system.example.id
-}
\ No newline at end of file
+}
+
+resource "goodaccess_relation_ac_s" "by_name" {
+  access_card_name = "Developers"
+  system_name      = "GitLab"
+}
+
+resource "goodaccess_relation_ac_s" "contractor" {
+  access_card_id = goodaccess_access_card.contractors.id
+  system_id      = goodaccess_system.example.id
+  expires_at     = "2026-12-31T23:59:59Z"
+}
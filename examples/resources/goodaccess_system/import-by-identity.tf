@@ -0,0 +1,6 @@
+import {
+  to = goodaccess_system.example
+  identity = {
+    id = "123"
+  }
+}
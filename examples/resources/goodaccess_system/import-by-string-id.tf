@@ -0,0 +1,4 @@
+import {
+  to = goodaccess_system.example
+  id = "123"
+}
@@ -1,2 +1 @@
-
 terraform import goodaccess_system.example 123
@@ -1,7 +1,39 @@
 resource "goodaccess_system" "example" {
   name     = "GoodAccess from tf"
   host     = "https://goodaccess22.com"
-  uri      = "https://goodaccess22.com"
-  port     = "8081"
+  port     = 8081
   protocol = "UDP"
+}
+
+resource "goodaccess_system" "multi_port" {
+  name = "TURN server"
+  host = "turn.example.com"
+
+  ports {
+    protocol  = "TCP"
+    from_port = 443
+    to_port   = 443
+  }
+
+  ports {
+    protocol  = "UDP"
+    from_port = 3478
+    to_port   = 3478
+  }
+}
+
+resource "goodaccess_system" "office_lan" {
+  name         = "Office LAN"
+  address_type = "cidr"
+  cidr         = "10.20.0.0/16"
+  port         = 22
+  protocol     = "TCP"
+}
+
+resource "goodaccess_system" "corp_apps" {
+  name         = "Corporate SaaS apps"
+  address_type = "domain"
+  host         = "*.corp.example.com"
+  port         = 443
+  protocol     = "TCP"
 }
\ No newline at end of file
@@ -0,0 +1,140 @@
+// Copyright (c) KRUKON s.r.o
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/http"
+)
+
+type AccessCardListModel struct {
+	Name types.String `tfsdk:"name"`
+	Tags types.Map    `tfsdk:"tags"`
+}
+
+var _ list.ListResourceWithConfigure = &AccessCardListResource{}
+
+func NewAccessCardListResource() list.ListResource {
+	return &AccessCardListResource{
+		client: &http.Client{},
+		token:  "",
+	}
+}
+
+// AccessCardListResource lists existing access cards for `terraform query`.
+type AccessCardListResource struct {
+	client             *http.Client
+	token              string
+	deletionProtection bool
+	defaultTags        map[string]string
+}
+
+func (r *AccessCardListResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "goodaccess_access_card"
+}
+
+func (r *AccessCardListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	data, ok := req.ProviderData.(goodAccessProviderModel)
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	if !ok {
+		resp.Diagnostics.AddError("Configuration Error", "Failed to retrieve provider data model.")
+		return
+	}
+	if data.Token.IsNull() {
+		resp.Diagnostics.AddError("Missing Credentials", "The provider's token, or client_id and client_secret, must be set to use GoodAccess resources and data sources.")
+		return
+	}
+
+	r.client = data.client
+	r.token = data.Token.ValueString()
+	r.deletionProtection = data.DeletionProtection.ValueBool()
+
+	defaultTags, diags := providerDefaultTags(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	r.defaultTags = defaultTags
+}
+
+func (r *AccessCardListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists GoodAccess access cards, optionally filtered by name and tags.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list access cards with exactly this name.",
+			},
+			"tags": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Only list access cards that have all of these tags.",
+			},
+		},
+	}
+}
+
+func (r *AccessCardListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config AccessCardListModel
+	diags := req.Config.Get(ctx, &config)
+
+	filter := map[string]string{}
+	if !diags.HasError() && !config.Tags.IsNull() {
+		diags.Append(config.Tags.ElementsAs(ctx, &filter, false)...)
+	}
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	cards, err := fetchAccessCards(r.client, r.token)
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Failed to read access card list: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for _, c := range cards {
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			if !config.Name.IsNull() && c.Name != config.Name.ValueString() {
+				continue
+			}
+			if !tagsMatch(c.Labels, filter) {
+				continue
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = c.Name
+			result.Diagnostics.Append(result.Identity.Set(ctx, accessCardIdentityModel{ID: types.StringValue(c.ID)})...)
+
+			if req.IncludeResource {
+				// System assignments are left to goodaccess_access_card_system,
+				// which can be listed separately.
+				data := AccessCardModel{
+					DeletionProtection: types.BoolValue(r.deletionProtection),
+					Tags:               types.MapNull(types.StringType),
+					TagsAll:            types.MapNull(types.StringType),
+					SystemIDs:          types.SetNull(types.StringType),
+					CopiedSystemIDs:    types.SetNull(types.StringType),
+				}
+				result.Diagnostics.Append(data.fromAPI(ctx, c, r.defaultTags)...)
+				result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+			}
+
+			count++
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
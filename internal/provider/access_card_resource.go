@@ -7,17 +7,65 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"io"
+	"maps"
 	"net/http"
+	"slices"
 )
 
 type AccessCardModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Description        types.String `tfsdk:"description"`
+	AdoptExisting      types.Bool   `tfsdk:"adopt_existing"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	ForceDetach        types.Bool   `tfsdk:"force_detach"`
+	Tags               types.Map    `tfsdk:"tags"`
+	TagsAll            types.Map    `tfsdk:"tags_all"`
+	SystemIDs          types.Set    `tfsdk:"system_ids"`
+	SourceAccessCardID types.String `tfsdk:"source_access_card_id"`
+	CopiedSystemIDs    types.Set    `tfsdk:"copied_system_ids"`
+}
+
+// accessCardAPIModel is an access card as returned by the GoodAccess API.
+type accessCardAPIModel struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Labels      map[string]string `json:"labels,omitempty"`
+}
+
+// fromAPI copies an access card returned by the API into m. Attributes that
+// only exist in the configuration, like system_ids, are left alone.
+func (m *AccessCardModel) fromAPI(ctx context.Context, c accessCardAPIModel, defaultTags map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(c.ID)
+	m.Name = types.StringValue(c.Name)
+	m.Description = types.StringValue(c.Description)
+	m.Tags, m.TagsAll, diags = tagsFromAPI(ctx, c.Labels, defaultTags, m.Tags)
+	return diags
+}
+
+var (
+	_ resource.ResourceWithModifyPlan       = &AccessCardResource{}
+	_ resource.ResourceWithConfigValidators = &AccessCardResource{}
+	_ resource.ResourceWithIdentity         = &AccessCardResource{}
+	_ resource.ResourceWithImportState      = &AccessCardResource{}
+)
+
+// accessCardIdentityModel identifies a goodaccess_access_card.
+type accessCardIdentityModel struct {
+	ID types.String `tfsdk:"id"`
 }
 
 func NewAccessCardResource() resource.Resource {
@@ -28,8 +76,10 @@ func NewAccessCardResource() resource.Resource {
 }
 
 type AccessCardResource struct {
-	client *http.Client
-	token  string
+	client   *http.Client
+	token    string
+	defaults providerDefaults
+	readOnly bool
 }
 
 func (r *AccessCardResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -39,11 +89,27 @@ func (r *AccessCardResource) Configure(ctx context.Context, req resource.Configu
 		return
 	}
 
-	if !ok || data.Token.IsNull() {
+	if !ok {
 		resp.Diagnostics.AddError("Configuration Error", "Failed to retrieve provider data model.")
 		return
 	}
+	r.readOnly = data.ReadOnly.ValueBool()
+
+	defaults, diags := newProviderDefaults(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	r.defaults = defaults
+
+	// Credentials that are not known until apply leave the resource
+	// without a client for now; read_only still applies to the plan.
+	if data.client == nil {
+		return
+	}
+	if data.Token.IsNull() {
+		resp.Diagnostics.AddError("Missing Credentials", "The provider's token, or client_id and client_secret, must be set to use GoodAccess resources and data sources.")
+		return
+	}
 
+	r.client = data.client
 	r.token = data.Token.ValueString()
 }
 
@@ -59,10 +125,76 @@ func (r *AccessCardResource) Schema(ctx context.Context, req resource.SchemaRequ
 			"description": schema.StringAttribute{
 				Optional: true,
 			},
+			"adopt_existing": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Take over an existing access card with the same name instead of creating a new one.",
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Refuse to delete this access card while true. Defaults to the provider's `deletion_protection` setting.",
+			},
+			"tags": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Tags of the access card, e.g. environment or owner. Merged with the provider's `default_tags` into `tags_all`.",
+			},
+			"tags_all": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "All tags of the access card, including the provider's `default_tags` and `managed_by`. Sent to GoodAccess as labels.",
+			},
+			"force_detach": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Delete all access card ↔ system relations of this access card before deleting it.",
+			},
+			"system_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "IDs of the systems assigned to this access card. When set, the access card manages all of its relations and must not be combined with `goodaccess_access_card_system` resources for the same card. Removing the attribute stops managing the relations without deleting them.",
+			},
+			"source_access_card_id": schema.StringAttribute{
+				Optional:      true,
+				Description:   "ID of an access card whose system assignments are copied to this one when it is created. Changing it recreates the access card. Conflicts with `system_ids`.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"copied_system_ids": schema.SetAttribute{
+				ElementType:   types.StringType,
+				Computed:      true,
+				Description:   "IDs of the systems copied from `source_access_card_id` at creation.",
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *AccessCardResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "ID of the access card.",
+			},
 		},
 	}
 }
 
+// ImportState imports an access card by its ID, given either as the import ID
+// or as the `id` identity attribute. Its system assignments are not managed
+// until `system_ids` is configured.
+func (r *AccessCardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+	if r.defaults.deletionProtectionKnown {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), r.defaults.deletionProtection)...)
+	}
+}
+
+func (r *AccessCardResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		conflictsWith("system_ids", "source_access_card_id"),
+	}
+}
+
 func (r *AccessCardResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = "goodaccess_access_card"
 }
@@ -75,10 +207,33 @@ func (r *AccessCardResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	payload := map[string]string{
-		"name":        data.Name.ValueString(),
-		"description": data.Description.ValueString(),
+	payload, diags := accessCardPayload(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.AdoptExisting.ValueBool() {
+		existing, ok := r.findAdoptable(data, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if ok {
+			if err := updateAccessCard(r.client, r.token, existing.ID, payload); err != nil {
+				resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update adopted access card %s: %s", existing.ID, err))
+				return
+			}
+
+			data.ID = types.StringValue(existing.ID)
+			resp.Diagnostics.Append(r.syncSystems(ctx, existing.ID, data.SystemIDs)...)
+			data.CopiedSystemIDs = r.copySystems(ctx, existing.ID, data.SourceAccessCardID, &resp.Diagnostics)
+			diags = resp.State.Set(ctx, &data)
+			resp.Diagnostics.Append(diags...)
+			resp.Diagnostics.Append(resp.Identity.Set(ctx, accessCardIdentityModel{ID: data.ID})...)
+			return
+		}
 	}
+
 	body, _ := json.Marshal(payload)
 
 	httpReq, _ := http.NewRequest("POST", "https://integration.goodaccess.com/api/v1/access-card", bytes.NewBuffer(body))
@@ -86,20 +241,37 @@ func (r *AccessCardResource) Create(ctx context.Context, req resource.CreateRequ
 	httpReq.Header.Add("Content-Type", "application/json")
 
 	httpResp, err := r.client.Do(httpReq)
-	if err != nil || httpResp.StatusCode != 200 {
-		resp.Diagnostics.AddError("API Error", fmt.Sprintf("POST failed: %v", err))
+	if err != nil {
+		resp.Diagnostics.AddError("Network Error", fmt.Sprintf("POST request failed: %s", err))
 		return
 	}
 	defer httpResp.Body.Close()
 
+	if httpResp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(httpResp.Body)
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Create failed: %d: %s", httpResp.StatusCode, bodyBytes))
+		return
+	}
+
 	var result struct {
 		CreatedID string `json:"created_id"`
 	}
-	json.NewDecoder(httpResp.Body).Decode(&result)
+	if err := json.NewDecoder(httpResp.Body).Decode(&result); err != nil {
+		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Could not parse success response: %s", err))
+		return
+	}
+	// Without an ID the systems would be assigned to no access card at all.
+	if result.CreatedID == "" {
+		resp.Diagnostics.AddError("API Error", "Create succeeded but the response did not contain the ID of the new access card.")
+		return
+	}
 
 	data.ID = types.StringValue(result.CreatedID)
+	resp.Diagnostics.Append(r.syncSystems(ctx, result.CreatedID, data.SystemIDs)...)
+	data.CopiedSystemIDs = r.copySystems(ctx, result.CreatedID, data.SourceAccessCardID, &resp.Diagnostics)
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, accessCardIdentityModel{ID: data.ID})...)
 }
 func (r *AccessCardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state AccessCardModel
@@ -116,53 +288,38 @@ func (r *AccessCardResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	url := fmt.Sprintf("https://integration.goodaccess.com/api/v1/access-card/%s", id)
-
-	httpReq, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		resp.Diagnostics.AddError("Request Error", fmt.Sprintf("Failed to create GET request: %s", err))
-		return
-	}
-	httpReq.Header.Add("Authorization", "Bearer "+r.token)
-	httpReq.Header.Add("Accept", "*/*")
-
-	httpResp, err := r.client.Do(httpReq)
+	result, err := fetchAccessCard(r.client, r.token, id)
 	if err != nil {
-		resp.Diagnostics.AddError("Network Error", fmt.Sprintf("Failed to send GET request: %s", err))
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read access card: %s", err))
 		return
 	}
-	defer httpResp.Body.Close()
-
-	if httpResp.StatusCode == http.StatusNotFound {
+	if result == nil {
 		// Access card no longer exists — remove from state
 		resp.State.RemoveResource(ctx)
 		return
 	}
 
-	if httpResp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(httpResp.Body)
-		resp.Diagnostics.AddError("API Error", fmt.Sprintf("GET failed with status %d: %s", httpResp.StatusCode, string(bodyBytes)))
-		return
-	}
+	resp.Diagnostics.Append(state.fromAPI(ctx, *result, r.defaults.tags)...)
 
-	// Parse response
-	var result struct {
-		ID          string `json:"id"`
-		Name        string `json:"name"`
-		Description string `json:"description"`
-	}
-	bodyBytes, _ := io.ReadAll(httpResp.Body)
-	if err := json.Unmarshal(bodyBytes, &result); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Failed to decode response: %s", err))
-		return
+	// State from before deletion_protection existed takes the provider
+	// default, as ModifyPlan would, so it does not plan an update.
+	if state.DeletionProtection.IsNull() && r.defaults.deletionProtectionKnown {
+		state.DeletionProtection = types.BoolValue(r.defaults.deletionProtection)
 	}
 
-	state.ID = types.StringValue(result.ID)
-	state.Name = types.StringValue(result.Name)
-	state.Description = types.StringValue(result.Description)
+	if !state.SystemIDs.IsNull() {
+		assigned, err := r.assignedSystems(id)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read systems of access card %s: %s", id, err))
+			return
+		}
+		state.SystemIDs, diags = types.SetValueFrom(ctx, types.StringType, slices.Sorted(maps.Keys(assigned)))
+		resp.Diagnostics.Append(diags...)
+	}
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, accessCardIdentityModel{ID: state.ID})...)
 }
 
 func (r *AccessCardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -184,32 +341,19 @@ func (r *AccessCardResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	url := fmt.Sprintf("https://integration.goodaccess.com/api/v1/access-card/%s", id)
-
-	payload := map[string]string{
-		"name":        plan.Name.ValueString(),
-		"description": plan.Description.ValueString(),
-	}
-	body, _ := json.Marshal(payload)
-
-	httpReq, err := http.NewRequest("PUT", url, bytes.NewBuffer(body))
-	if err != nil {
-		resp.Diagnostics.AddError("Request Error", fmt.Sprintf("Failed to create PUT request: %s", err))
+	payload, diags := accessCardPayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	httpReq.Header.Add("Authorization", "Bearer "+r.token)
-	httpReq.Header.Add("Content-Type", "application/json")
 
-	httpResp, err := r.client.Do(httpReq)
-	if err != nil {
-		resp.Diagnostics.AddError("Network Error", fmt.Sprintf("PUT request failed: %s", err))
+	if err := updateAccessCard(r.client, r.token, id, payload); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update access card: %s", err))
 		return
 	}
-	defer httpResp.Body.Close()
 
-	if httpResp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(httpResp.Body)
-		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Update failed with status %d: %s", httpResp.StatusCode, string(bodyBytes)))
+	resp.Diagnostics.Append(r.syncSystems(ctx, id, plan.SystemIDs)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -217,6 +361,192 @@ func (r *AccessCardResource) Update(ctx context.Context, req resource.UpdateRequ
 	plan.ID = state.ID // preserve ID
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, accessCardIdentityModel{ID: plan.ID})...)
+}
+
+// ModifyPlan rejects names that are already taken by another access card so
+// the conflict is reported at plan time rather than halfway through apply.
+func (r *AccessCardResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Checked last, against the plan as modified below.
+	defer rejectChangesIfReadOnly(r.readOnly, req, resp)
+
+	// Nothing to check on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan AccessCardModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planProviderDefaults(ctx, r.defaults, req, resp)
+
+	// The remaining checks need the API.
+	if resp.Diagnostics.HasError() || r.token == "" {
+		return
+	}
+
+	var state AccessCardModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if plan.Name.IsUnknown() || plan.Name.Equal(state.Name) {
+		return
+	}
+	// Adoption takes over the same-named card instead of colliding with it.
+	if state.ID.IsNull() && plan.AdoptExisting.ValueBool() {
+		return
+	}
+
+	cards, err := fetchAccessCards(r.client, r.token)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Name Check Skipped", fmt.Sprintf("Could not list access cards to check for name collisions: %s", err))
+		return
+	}
+
+	for _, c := range cards {
+		if c.Name == plan.Name.ValueString() && c.ID != state.ID.ValueString() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Duplicate Access Card Name",
+				fmt.Sprintf("An access card named %q already exists (ID %s). Choose a different name, import it, or set adopt_existing.", c.Name, c.ID),
+			)
+			return
+		}
+	}
+}
+
+// assignedSystems returns the systems currently related to access card id,
+// mapped to the ID of the relation.
+func (r *AccessCardResource) assignedSystems(id string) (map[string]string, error) {
+	relations, err := fetchRelations(r.client, r.token)
+	if err != nil {
+		return nil, err
+	}
+
+	assigned := map[string]string{}
+	for _, rel := range relations {
+		if rel.AccessCardID == id {
+			assigned[rel.SystemID] = rel.ID
+		}
+	}
+	return assigned, nil
+}
+
+// syncSystems creates and deletes relations so that access card id is
+// assigned exactly the systems in want. A null want leaves relations alone.
+func (r *AccessCardResource) syncSystems(ctx context.Context, id string, want types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if want.IsNull() || want.IsUnknown() {
+		return diags
+	}
+
+	var wanted []string
+	diags.Append(want.ElementsAs(ctx, &wanted, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	assigned, err := r.assignedSystems(id)
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Failed to read systems of access card %s: %s", id, err))
+		return diags
+	}
+
+	for _, systemID := range wanted {
+		if _, ok := assigned[systemID]; ok {
+			continue
+		}
+		if err := createRelation(r.client, r.token, id, systemID, ""); err != nil {
+			diags.AddError("API Error", fmt.Sprintf("Failed to assign system %s to access card %s: %s", systemID, id, err))
+			return diags
+		}
+	}
+
+	for systemID, relationID := range assigned {
+		if slices.Contains(wanted, systemID) {
+			continue
+		}
+		if err := deleteRelation(r.client, r.token, relationID); err != nil {
+			diags.AddError("API Error", fmt.Sprintf("Failed to remove system %s from access card %s: %s", systemID, id, err))
+			return diags
+		}
+	}
+	return diags
+}
+
+// copySystems assigns every system of the source access card to access card
+// id and returns the IDs of the copied systems. Systems that are already
+// assigned are not copied again.
+func (r *AccessCardResource) copySystems(ctx context.Context, id string, source types.String, diags *diag.Diagnostics) types.Set {
+	if source.IsNull() {
+		return types.SetNull(types.StringType)
+	}
+
+	sourceSystems, err := r.assignedSystems(source.ValueString())
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Failed to read systems of source access card %s: %s", source.ValueString(), err))
+		return types.SetNull(types.StringType)
+	}
+	assigned, err := r.assignedSystems(id)
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Failed to read systems of access card %s: %s", id, err))
+		return types.SetNull(types.StringType)
+	}
+
+	copied := []string{}
+	for _, systemID := range slices.Sorted(maps.Keys(sourceSystems)) {
+		if _, ok := assigned[systemID]; !ok {
+			if err := createRelation(r.client, r.token, id, systemID, ""); err != nil {
+				diags.AddError("API Error", fmt.Sprintf("Failed to copy system %s to access card %s: %s", systemID, id, err))
+				break
+			}
+		}
+		copied = append(copied, systemID)
+	}
+
+	result, d := types.SetValueFrom(ctx, types.StringType, copied)
+	diags.Append(d...)
+	return result
+}
+
+// findAdoptable looks for an existing access card with the same name as the
+// plan. More than one match is reported as an error because there is no safe
+// way to pick one.
+func (r *AccessCardResource) findAdoptable(data AccessCardModel, diags *diag.Diagnostics) (accessCardAPIModel, bool) {
+	cards, err := fetchAccessCards(r.client, r.token)
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Failed to look up existing access cards: %s", err))
+		return accessCardAPIModel{}, false
+	}
+
+	var matches []accessCardAPIModel
+	for _, c := range cards {
+		if c.Name == data.Name.ValueString() {
+			matches = append(matches, c)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return accessCardAPIModel{}, false
+	case 1:
+		return matches[0], true
+	default:
+		diags.AddError(
+			"Ambiguous Adoption",
+			fmt.Sprintf("Found %d existing access cards named %q. Remove the duplicates or import the right one explicitly.",
+				len(matches), data.Name.ValueString()),
+		)
+		return accessCardAPIModel{}, false
+	}
 }
 
 func (r *AccessCardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -234,6 +564,44 @@ func (r *AccessCardResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() || (state.DeletionProtection.IsNull() && r.defaults.deletionProtection) {
+		resp.Diagnostics.AddError(
+			"Deletion Protection Enabled",
+			fmt.Sprintf("Access card %q (%s) has deletion_protection enabled. Set deletion_protection = false and apply before destroying it.", state.Name.ValueString(), id),
+		)
+		return
+	}
+
+	if state.ForceDetach.ValueBool() {
+		removed, err := detachRelations(r.client, r.token, func(rel relationAPIModel) bool {
+			return rel.AccessCardID == id
+		})
+		for _, rel := range removed {
+			resp.Diagnostics.AddWarning(
+				"Relation Detached",
+				fmt.Sprintf("Removed relation %s between access card %s and system %s.", rel.ID, rel.AccessCardID, rel.SystemID),
+			)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to detach relations from access card %s: %s", id, err))
+			return
+		}
+	} else if !state.SystemIDs.IsNull() {
+		// Relations managed through system_ids go away with the card.
+		var managed []string
+		resp.Diagnostics.Append(state.SystemIDs.ElementsAs(ctx, &managed, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		_, err := detachRelations(r.client, r.token, func(rel relationAPIModel) bool {
+			return rel.AccessCardID == id && slices.Contains(managed, rel.SystemID)
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to remove systems from access card %s: %s", id, err))
+			return
+		}
+	}
+
 	url := fmt.Sprintf("https://integration.goodaccess.com/api/v1/access-card/%s", id)
 
 	httpReq, err := http.NewRequest("DELETE", url, nil)
@@ -256,3 +624,98 @@ func (r *AccessCardResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 }
+
+// accessCardPayload builds the create/update request body for an access card.
+func accessCardPayload(ctx context.Context, data AccessCardModel) (map[string]interface{}, diag.Diagnostics) {
+	labels, diags := tagsToAPI(ctx, data.TagsAll)
+	return map[string]interface{}{
+		"name":        data.Name.ValueString(),
+		"description": data.Description.ValueString(),
+		"labels":      labels,
+	}, diags
+}
+
+// fetchAccessCard returns a single access card, or nil if it does not exist.
+func fetchAccessCard(client *http.Client, token, id string) (*accessCardAPIModel, error) {
+	url := fmt.Sprintf("https://integration.goodaccess.com/api/v1/access-card/%s", id)
+
+	httpReq, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GET request: %w", err)
+	}
+	httpReq.Header.Add("Authorization", "Bearer "+token)
+	httpReq.Header.Add("Accept", "*/*")
+
+	httpResp, err := client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send GET request: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	bodyBytes, _ := io.ReadAll(httpResp.Body)
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET failed with status %d: %s", httpResp.StatusCode, string(bodyBytes))
+	}
+
+	var result accessCardAPIModel
+	if err := json.Unmarshal(bodyBytes, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return &result, nil
+}
+
+// fetchAccessCards lists all access cards visible to the token.
+func fetchAccessCards(client *http.Client, token string) ([]accessCardAPIModel, error) {
+	httpReq, err := http.NewRequest("GET", "https://integration.goodaccess.com/api/v1/access-cards", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Add("Authorization", "Bearer "+token)
+	httpReq.Header.Add("Accept", "*/*")
+
+	httpResp, err := client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("GET request failed: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	bodyBytes, _ := io.ReadAll(httpResp.Body)
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("status %d: %s", httpResp.StatusCode, string(bodyBytes))
+	}
+
+	var cards []accessCardAPIModel
+	if err := json.Unmarshal(bodyBytes, &cards); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	return cards, nil
+}
+
+// updateAccessCard replaces the name and description of an existing access card.
+func updateAccessCard(client *http.Client, token, id string, payload map[string]interface{}) error {
+	body, _ := json.Marshal(payload)
+
+	url := fmt.Sprintf("https://integration.goodaccess.com/api/v1/access-card/%s", id)
+	httpReq, err := http.NewRequest("PUT", url, bytes.NewBuffer(body))
+	if err != nil {
+		return fmt.Errorf("failed to create PUT request: %w", err)
+	}
+	httpReq.Header.Add("Authorization", "Bearer "+token)
+	httpReq.Header.Add("Content-Type", "application/json")
+
+	httpResp, err := client.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("PUT request failed: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(httpResp.Body)
+		return fmt.Errorf("update failed with status %d: %s", httpResp.StatusCode, string(bodyBytes))
+	}
+	return nil
+}
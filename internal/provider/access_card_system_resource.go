@@ -0,0 +1,90 @@
+// Copyright (c) KRUKON s.r.o
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"net/http"
+	"strings"
+)
+
+// goodaccess_access_card_system is the new name of goodaccess_relation_ac_s.
+// Both share RelationACSResource; only the name, the deprecation and state
+// moves differ.
+const relationACSTypeName = "goodaccess_relation_ac_s"
+
+var (
+	_ resource.ResourceWithMoveState = &AccessCardSystemResource{}
+	_ resource.ResourceWithIdentity  = &AccessCardSystemResource{}
+)
+
+func NewAccessCardSystemResource() resource.Resource {
+	return &AccessCardSystemResource{
+		RelationACSResource: RelationACSResource{
+			client: &http.Client{},
+			token:  "",
+		},
+	}
+}
+
+type AccessCardSystemResource struct {
+	RelationACSResource
+}
+
+func (r *AccessCardSystemResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "goodaccess_access_card_system"
+}
+
+func (r *AccessCardSystemResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	r.RelationACSResource.Schema(ctx, req, resp)
+	resp.Schema.DeprecationMessage = ""
+}
+
+// MoveState lets `moved` blocks turn a goodaccess_relation_ac_s into a
+// goodaccess_access_card_system without touching the API.
+func (r *AccessCardSystemResource) MoveState(ctx context.Context) []resource.StateMover {
+	var source resource.SchemaResponse
+	r.RelationACSResource.Schema(ctx, resource.SchemaRequest{}, &source)
+
+	return []resource.StateMover{
+		{
+			SourceSchema: &source.Schema,
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				// Leave moves from other resource types to other movers.
+				if req.SourceTypeName != relationACSTypeName || !strings.HasSuffix(req.SourceProviderAddress, "/goodaccess") {
+					return
+				}
+
+				var data RelationACSTFModel
+				resp.Diagnostics.Append(req.SourceState.Get(ctx, &data)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, &data)...)
+				resp.Diagnostics.Append(resp.TargetIdentity.Set(ctx, data.identity())...)
+			},
+		},
+	}
+}
+
+func NewAccessCardSystemListResource() list.ListResource {
+	return &AccessCardSystemListResource{
+		RelationACSListResource: RelationACSListResource{
+			client: &http.Client{},
+			token:  "",
+		},
+	}
+}
+
+// AccessCardSystemListResource lists relations as
+// goodaccess_access_card_system resources.
+type AccessCardSystemListResource struct {
+	RelationACSListResource
+}
+
+func (r *AccessCardSystemListResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "goodaccess_access_card_system"
+}
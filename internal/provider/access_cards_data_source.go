@@ -0,0 +1,142 @@
+// Copyright (c) KRUKON s.r.o
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/http"
+)
+
+type AccessCardsDataSourceModel struct {
+	Name        types.String                     `tfsdk:"name"`
+	Tags        types.Map                        `tfsdk:"tags"`
+	IDs         []types.String                   `tfsdk:"ids"`
+	AccessCards []AccessCardsDataSourceItemModel `tfsdk:"access_cards"`
+}
+
+type AccessCardsDataSourceItemModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Tags        types.Map    `tfsdk:"tags"`
+}
+
+func NewAccessCardsDataSource() datasource.DataSource {
+	return &AccessCardsDataSource{
+		client: &http.Client{},
+		token:  "",
+	}
+}
+
+type AccessCardsDataSource struct {
+	client *http.Client
+	token  string
+}
+
+func (d *AccessCardsDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "goodaccess_access_cards"
+}
+
+func (d *AccessCardsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	data, ok := req.ProviderData.(goodAccessProviderModel)
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	if !ok {
+		resp.Diagnostics.AddError("Configuration Error", "Failed to retrieve provider data model.")
+		return
+	}
+	if data.Token.IsNull() {
+		resp.Diagnostics.AddError("Missing Credentials", "The provider's token, or client_id and client_secret, must be set to use GoodAccess resources and data sources.")
+		return
+	}
+
+	d.client = data.client
+	d.token = data.Token.ValueString()
+}
+
+func (d *AccessCardsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists GoodAccess access cards, optionally filtered by name and tags.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return access cards with exactly this name.",
+			},
+			"tags": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Only return access cards that have all of these tags.",
+			},
+			"ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "IDs of the matching access cards.",
+			},
+			"access_cards": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching access cards.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":          schema.StringAttribute{Computed: true},
+						"name":        schema.StringAttribute{Computed: true},
+						"description": schema.StringAttribute{Computed: true},
+						"tags":        schema.MapAttribute{ElementType: types.StringType, Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AccessCardsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AccessCardsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := map[string]string{}
+	if !data.Tags.IsNull() {
+		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &filter, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	cards, err := fetchAccessCards(d.client, d.token)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read access card list: %s", err))
+		return
+	}
+
+	data.IDs = []types.String{}
+	data.AccessCards = []AccessCardsDataSourceItemModel{}
+	for _, c := range cards {
+		if !data.Name.IsNull() && c.Name != data.Name.ValueString() {
+			continue
+		}
+		if !tagsMatch(c.Labels, filter) {
+			continue
+		}
+
+		tags, diags := types.MapValueFrom(ctx, types.StringType, c.Labels)
+		resp.Diagnostics.Append(diags...)
+
+		data.IDs = append(data.IDs, types.StringValue(c.ID))
+		data.AccessCards = append(data.AccessCards, AccessCardsDataSourceItemModel{
+			ID:          types.StringValue(c.ID),
+			Name:        types.StringValue(c.Name),
+			Description: types.StringValue(c.Description),
+			Tags:        tags,
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,153 @@
+// Copyright (c) KRUKON s.r.o
+
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/oauth2"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+// newTransport builds the transport for all API and token requests from the
+// provider's proxy and TLS settings.
+func newTransport(config goodAccessProviderModel) (*http.Transport, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if !config.ProxyURL.IsNull() {
+		proxy, err := url.Parse(config.ProxyURL.ValueString())
+		if err != nil || proxy.Host == "" {
+			// Neither the value nor the parse error, which quotes it, is
+			// repeated, as the URL may carry the proxy password.
+			diags.AddAttributeError(path.Root("proxy_url"), "Invalid Proxy URL", "proxy_url is not a valid proxy URL, expected e.g. http://proxy.example.com:3128.")
+			return nil, diags
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	caPEM := []byte(config.CACertPEM.ValueString())
+	switch {
+	case !config.CACertPEM.IsNull() && !config.CACertFile.IsNull():
+		diags.AddAttributeError(path.Root("ca_cert_file"), "Conflicting CA Certificates", "Use either ca_cert_pem or ca_cert_file, but not both.")
+		return nil, diags
+	case !config.CACertFile.IsNull():
+		var err error
+		caPEM, err = os.ReadFile(config.CACertFile.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("ca_cert_file"), "Invalid CA Certificate File", fmt.Sprintf("Failed to read %s: %s", config.CACertFile.ValueString(), err))
+			return nil, diags
+		}
+	}
+	if len(caPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caPEM) {
+			diags.AddError("Invalid CA Certificate", "No PEM encoded certificate found in ca_cert_pem or ca_cert_file.")
+			return nil, diags
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	switch {
+	case config.ClientCert.IsNull() != config.ClientKey.IsNull():
+		diags.AddError("Incomplete Client Certificate", "client_cert and client_key must be set together.")
+		return nil, diags
+	case !config.ClientCert.IsNull():
+		cert, err := tls.X509KeyPair([]byte(config.ClientCert.ValueString()), []byte(config.ClientKey.ValueString()))
+		if err != nil {
+			diags.AddAttributeError(path.Root("client_cert"), "Invalid Client Certificate", fmt.Sprintf("Failed to load client_cert and client_key: %s", err))
+			return nil, diags
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if config.InsecureSkipVerify.ValueBool() {
+		diags.AddAttributeWarning(
+			path.Root("insecure_skip_verify"),
+			"TLS Verification Disabled",
+			"The GoodAccess API certificate is not verified, so anyone able to intercept the connection can read the API token. Use ca_cert_pem or ca_cert_file instead where possible.",
+		)
+		tlsConfig.InsecureSkipVerify = true
+	}
+
+	transport.TLSClientConfig = tlsConfig
+	return transport, diags
+}
+
+// userAgent identifies the provider and Terraform versions to the API, so
+// GoodAccess can tell which client made a change.
+func userAgent(version, terraformVersion, suffix string) string {
+	ua := fmt.Sprintf("terraform-provider-goodaccess/%s (+terraform %s)", version, terraformVersion)
+	if suffix != "" {
+		ua += " " + suffix
+	}
+	return ua
+}
+
+// userAgentTransport sets the User-Agent of every request.
+type userAgentTransport struct {
+	base      http.RoundTripper
+	userAgent string
+}
+
+func (t *userAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the caller's request.
+	req = req.Clone(req.Context())
+	req.Header.Set("User-Agent", t.userAgent)
+	return t.base.RoundTrip(req)
+}
+
+// newHTTPClient builds the HTTP client shared by all resources and data
+// sources. Its transport authorises every request, replacing any
+// Authorization header set by the caller.
+//
+// With client credentials the first token is obtained right away, so wrong
+// credentials fail the provider configuration, and is stored as
+// config.Token. Later tokens are fetched by the transport as earlier ones
+// expire, which keeps long applies working.
+func newHTTPClient(config *goodAccessProviderModel) (*http.Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	base := config.transport
+
+	var src oauth2.TokenSource
+	switch {
+	case !config.ClientID.IsNull():
+		tokenURL := defaultTokenURL
+		if !config.TokenURL.IsNull() {
+			tokenURL = config.TokenURL.ValueString()
+		}
+
+		// The token source outlives the Configure call, so it must not
+		// use its context.
+		src = tokenSource(context.Background(), &http.Client{Transport: base}, tokenURL, config.ClientID.ValueString(), config.ClientSecret.ValueString(), "")
+		token, err := src.Token()
+		if err != nil {
+			diags.AddError("Authentication Error", fmt.Sprintf("Failed to obtain a token from %s: %s", tokenURL, err))
+			return nil, diags
+		}
+		config.Token = types.StringValue(token.AccessToken)
+	case !config.Token.IsNull():
+		src = oauth2.StaticTokenSource(&oauth2.Token{AccessToken: config.Token.ValueString()})
+	default:
+		return &http.Client{Transport: base}, diags
+	}
+
+	return &http.Client{
+		Transport: &oauth2.Transport{
+			Source: oauth2.ReuseTokenSource(nil, src),
+			Base:   base,
+		},
+	}, diags
+}
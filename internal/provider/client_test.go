@@ -0,0 +1,118 @@
+// Copyright (c) KRUKON s.r.o
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+// clientCredentialsConfig returns a provider configuration that
+// authenticates against f with the given client credentials.
+func clientCredentialsConfig(t *testing.T, f *fakeAPI, clientID, clientSecret string) goodAccessProviderModel {
+	t.Helper()
+
+	config := goodAccessProviderModel{
+		ClientID:     types.StringValue(clientID),
+		ClientSecret: types.StringValue(clientSecret),
+		TokenURL:     types.StringValue(f.tokenURL()),
+	}
+	transport, diags := newTransport(config)
+	if diags.HasError() {
+		t.Fatalf("newTransport: %v", diags)
+	}
+	config.transport = transport
+	return config
+}
+
+// get sends n requests to the fake's tenant endpoint through client.
+func get(t *testing.T, client *http.Client, f *fakeAPI, n int) {
+	t.Helper()
+
+	for range n {
+		resp, err := client.Get(f.URL + "/api/v1/tenant")
+		if err != nil {
+			t.Fatalf("GET tenant: %s", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("GET tenant: status %d", resp.StatusCode)
+		}
+	}
+}
+
+func TestNewHTTPClientClientCredentials(t *testing.T) {
+	f := newFakeAPI(t, "id", "secret", 3600)
+	config := clientCredentialsConfig(t, f, "id", "secret")
+
+	client, diags := newHTTPClient(&config)
+	if diags.HasError() {
+		t.Fatalf("newHTTPClient: %v", diags)
+	}
+	if got := config.Token.ValueString(); got != "token-1" {
+		t.Errorf("config.Token = %q, want the token obtained while configuring", got)
+	}
+
+	get(t, client, f, 2)
+
+	want := []string{"Bearer token-1", "Bearer token-1"}
+	if got := f.seen(); !slices.Equal(got, want) {
+		t.Errorf("Authorization headers = %q, want %q", got, want)
+	}
+	if got := f.issued(); got != 1 {
+		t.Errorf("tokens issued = %d, want a valid token to be reused", got)
+	}
+}
+
+func TestNewHTTPClientRefreshesExpiredToken(t *testing.T) {
+	// Tokens this short-lived count as expired straight away, so every
+	// request needs a new one.
+	f := newFakeAPI(t, "id", "secret", 1)
+	config := clientCredentialsConfig(t, f, "id", "secret")
+
+	client, diags := newHTTPClient(&config)
+	if diags.HasError() {
+		t.Fatalf("newHTTPClient: %v", diags)
+	}
+
+	get(t, client, f, 2)
+
+	want := []string{"Bearer token-2", "Bearer token-3"}
+	if got := f.seen(); !slices.Equal(got, want) {
+		t.Errorf("Authorization headers = %q, want %q", got, want)
+	}
+}
+
+func TestNewHTTPClientInvalidClientCredentials(t *testing.T) {
+	f := newFakeAPI(t, "id", "secret", 3600)
+	config := clientCredentialsConfig(t, f, "id", "wrong")
+
+	if _, diags := newHTTPClient(&config); !diags.HasError() {
+		t.Fatal("expected wrong client credentials to fail the configuration")
+	}
+	if got := f.issued(); got != 0 {
+		t.Errorf("tokens issued = %d, want none", got)
+	}
+}
+
+func TestNewHTTPClientStaticToken(t *testing.T) {
+	f := newFakeAPI(t, "id", "secret", 3600)
+	config := goodAccessProviderModel{Token: types.StringValue("static")}
+
+	client, diags := newHTTPClient(&config)
+	if diags.HasError() {
+		t.Fatalf("newHTTPClient: %v", diags)
+	}
+
+	get(t, client, f, 1)
+
+	want := []string{"Bearer static"}
+	if got := f.seen(); !slices.Equal(got, want) {
+		t.Errorf("Authorization headers = %q, want %q", got, want)
+	}
+	if got := f.issued(); got != 0 {
+		t.Errorf("tokens issued = %d, want none for a static token", got)
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright (c) KRUKON s.r.o
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+// fakeAPI is a stand-in for the GoodAccess API, so authentication can be
+// tested offline. It issues numbered tokens from /api/v1/oauth/token and
+// records the Authorization header of every other request.
+type fakeAPI struct {
+	*httptest.Server
+
+	clientID     string
+	clientSecret string
+	// expiresIn is the lifetime in seconds reported for issued tokens.
+	expiresIn int
+
+	mu             sync.Mutex
+	tokensIssued   int
+	authorizations []string
+}
+
+func newFakeAPI(t *testing.T, clientID, clientSecret string, expiresIn int) *fakeAPI {
+	t.Helper()
+
+	f := &fakeAPI{clientID: clientID, clientSecret: clientSecret, expiresIn: expiresIn}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v1/oauth/token", f.token)
+	mux.HandleFunc("GET /api/v1/tenant", f.tenant)
+	f.Server = httptest.NewServer(mux)
+	t.Cleanup(f.Close)
+	return f
+}
+
+// tokenURL is the fake's OAuth2 token endpoint.
+func (f *fakeAPI) tokenURL() string {
+	return f.URL + "/api/v1/oauth/token"
+}
+
+func (f *fakeAPI) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Client credentials may come as basic auth or in the form.
+	id, secret, ok := r.BasicAuth()
+	if !ok {
+		id, secret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	if r.PostForm.Get("grant_type") != "client_credentials" || id != f.clientID || secret != f.clientSecret {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error":"invalid_client"}`))
+		return
+	}
+
+	f.mu.Lock()
+	f.tokensIssued++
+	n := f.tokensIssued
+	f.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"access_token": fmt.Sprintf("token-%d", n),
+		"token_type":   "Bearer",
+		"expires_in":   f.expiresIn,
+	})
+}
+
+func (f *fakeAPI) tenant(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	f.authorizations = append(f.authorizations, r.Header.Get("Authorization"))
+	f.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(`{"id":"tenant-1","name":"Example"}`))
+}
+
+// issued returns how many tokens the fake has handed out.
+func (f *fakeAPI) issued() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.tokensIssued
+}
+
+// seen returns the Authorization headers of the API requests so far.
+func (f *fakeAPI) seen() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.authorizations...)
+}
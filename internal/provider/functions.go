@@ -0,0 +1,183 @@
+// Copyright (c) KRUKON s.r.o
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ function.Function = &RelationIDFunction{}
+	_ function.Function = &ParseRelationIDFunction{}
+	_ function.Function = &NormalizeHostFunction{}
+	_ function.Function = &ValidatePortFunction{}
+)
+
+// RelationIDFunction implements provider::goodaccess::relation_id.
+type RelationIDFunction struct{}
+
+func NewRelationIDFunction() function.Function {
+	return &RelationIDFunction{}
+}
+
+func (f *RelationIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "relation_id"
+}
+
+func (f *RelationIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Builds the ID of a goodaccess_access_card_system",
+		Description: "Returns the ID goodaccess_access_card_system uses for the relation between an access card and a system.",
+		Parameters: []function.Parameter{
+			function.StringParameter{Name: "access_card_id", Description: "ID of the access card."},
+			function.StringParameter{Name: "system_id", Description: "ID of the system."},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *RelationIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var accessCardID, systemID string
+	resp.Error = req.Arguments.Get(ctx, &accessCardID, &systemID)
+	if resp.Error != nil {
+		return
+	}
+
+	if accessCardID == "" {
+		resp.Error = function.NewArgumentFuncError(0, "access_card_id must not be empty")
+		return
+	}
+	if systemID == "" {
+		resp.Error = function.NewArgumentFuncError(1, "system_id must not be empty")
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, relationID(accessCardID, systemID))
+}
+
+// ParseRelationIDFunction implements provider::goodaccess::parse_relation_id.
+type ParseRelationIDFunction struct{}
+
+func NewParseRelationIDFunction() function.Function {
+	return &ParseRelationIDFunction{}
+}
+
+// relationIDAttrTypes describes the object returned by parse_relation_id.
+var relationIDAttrTypes = map[string]attr.Type{
+	"access_card_id": types.StringType,
+	"system_id":      types.StringType,
+}
+
+func (f *ParseRelationIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_relation_id"
+}
+
+func (f *ParseRelationIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Splits the ID of a goodaccess_access_card_system",
+		Description: "Returns an object with the `access_card_id` and `system_id` a goodaccess_access_card_system ID is made of.",
+		Parameters: []function.Parameter{
+			function.StringParameter{Name: "id", Description: "ID of the relation, `<access_card_id>:<system_id>`."},
+		},
+		Return: function.ObjectReturn{AttributeTypes: relationIDAttrTypes},
+	}
+}
+
+func (f *ParseRelationIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	resp.Error = req.Arguments.Get(ctx, &id)
+	if resp.Error != nil {
+		return
+	}
+
+	accessCardID, systemID, err := parseRelationID(id)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, diags := types.ObjectValue(relationIDAttrTypes, map[string]attr.Value{
+		"access_card_id": types.StringValue(accessCardID),
+		"system_id":      types.StringValue(systemID),
+	})
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, result)
+}
+
+// NormalizeHostFunction implements provider::goodaccess::normalize_host.
+type NormalizeHostFunction struct{}
+
+func NewNormalizeHostFunction() function.Function {
+	return &NormalizeHostFunction{}
+}
+
+func (f *NormalizeHostFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_host"
+}
+
+func (f *NormalizeHostFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Normalises a host name or IP address",
+		Description: "Returns an IP address in its canonical form, or a domain name in lower-case ASCII with international labels " +
+			"converted to punycode and any trailing dot removed. A leading `*.` wildcard label is kept. Fails if the value is neither.",
+		Parameters: []function.Parameter{
+			function.StringParameter{Name: "host", Description: "Host name or IP address."},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *NormalizeHostFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var host string
+	resp.Error = req.Arguments.Get(ctx, &host)
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := normalizeHost(host)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, normalized)
+}
+
+// ValidatePortFunction implements provider::goodaccess::validate_port.
+type ValidatePortFunction struct{}
+
+func NewValidatePortFunction() function.Function {
+	return &ValidatePortFunction{}
+}
+
+func (f *ValidatePortFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "validate_port"
+}
+
+func (f *ValidatePortFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Checks a port or port range",
+		Description: "Returns whether the value is a port, e.g. `443`, or an ascending port range, e.g. `8000-8100`, " +
+			"that goodaccess_system accepts. Intended for `validation` blocks of module variables.",
+		Parameters: []function.Parameter{
+			function.StringParameter{Name: "spec", Description: "Port or port range."},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *ValidatePortFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var spec string
+	resp.Error = req.Arguments.Get(ctx, &spec)
+	if resp.Error != nil {
+		return
+	}
+
+	_, _, err := parsePortSpec(spec)
+	resp.Error = resp.Result.Set(ctx, err == nil)
+}
@@ -0,0 +1,98 @@
+// Copyright (c) KRUKON s.r.o
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// rejectChangesIfReadOnly fails the plan of any create, update or destroy
+// while the provider is configured with read_only. It compares the final
+// plan, so it must run after ModifyPlan has made its own changes.
+func rejectChangesIfReadOnly(readOnly bool, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !readOnly {
+		return
+	}
+
+	var action string
+	switch {
+	case req.State.Raw.IsNull():
+		action = "create"
+	case resp.Plan.Raw.IsNull():
+		action = "destroy"
+	case !resp.Plan.Raw.Equal(req.State.Raw):
+		action = "update"
+	default:
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"Read-Only Provider",
+		fmt.Sprintf("The provider is configured with read_only = true, so this plan must not %s GoodAccess objects. Remove read_only, or use a provider without it, to apply changes.", action),
+	)
+}
+
+// providerDefaults are the provider-wide settings that systems and access
+// cards fall back to. The zero value, for a resource whose provider is not
+// configured yet, knows none of them.
+type providerDefaults struct {
+	deletionProtection      bool
+	deletionProtectionKnown bool
+	// tags are default_tags plus managed_by.
+	tags      map[string]string
+	tagsKnown bool
+}
+
+func newProviderDefaults(ctx context.Context, data goodAccessProviderModel) (providerDefaults, diag.Diagnostics) {
+	tags, diags := providerDefaultTags(ctx, data)
+	return providerDefaults{
+		deletionProtection:      data.DeletionProtection.ValueBool(),
+		deletionProtectionKnown: !data.DeletionProtection.IsUnknown(),
+		tags:                    tags,
+		tagsKnown:               providerDefaultTagsKnown(data),
+	}, diags
+}
+
+// providerDefaultTagsKnown reports whether default_tags and managed_by are
+// fully known, so the default tags can be planned.
+func providerDefaultTagsKnown(data goodAccessProviderModel) bool {
+	if data.DefaultTags.IsUnknown() || data.ManagedBy.IsUnknown() {
+		return false
+	}
+	for _, v := range data.DefaultTags.Elements() {
+		if v.IsUnknown() {
+			return false
+		}
+	}
+	return true
+}
+
+// planProviderDefaults fills the provider-wide defaults into the plan of a
+// system or access card: deletion_protection when it is not configured, and
+// tags_all, the provider's default tags merged with the resource's own. Each
+// is planned as unknown while the provider setting it comes from is.
+func planProviderDefaults(ctx context.Context, defaults providerDefaults, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var deletionProtection types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("deletion_protection"), &deletionProtection)...)
+	if deletionProtection.IsNull() {
+		deletionProtection = types.BoolValue(defaults.deletionProtection)
+		if !defaults.deletionProtectionKnown {
+			deletionProtection = types.BoolUnknown()
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("deletion_protection"), deletionProtection)...)
+	}
+
+	var tags types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tags"), &tags)...)
+	tagsAll, diags := mergeTags(ctx, defaults.tags, tags)
+	resp.Diagnostics.Append(diags...)
+	if !defaults.tagsKnown {
+		tagsAll = types.MapUnknown(types.StringType)
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+}
@@ -0,0 +1,124 @@
+// Copyright (c) KRUKON s.r.o
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/http"
+)
+
+type RelationACSListModel struct {
+	AccessCardID types.String `tfsdk:"access_card_id"`
+	SystemID     types.String `tfsdk:"system_id"`
+}
+
+var _ list.ListResourceWithConfigure = &RelationACSListResource{}
+
+func NewRelationACSListResource() list.ListResource {
+	return &RelationACSListResource{
+		client: &http.Client{},
+		token:  "",
+	}
+}
+
+// RelationACSListResource lists existing access card ↔ system relations for
+// `terraform query`.
+type RelationACSListResource struct {
+	client *http.Client
+	token  string
+}
+
+func (r *RelationACSListResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = relationACSTypeName
+}
+
+func (r *RelationACSListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	data, ok := req.ProviderData.(goodAccessProviderModel)
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	if !ok {
+		resp.Diagnostics.AddError("Configuration Error", "Failed to retrieve provider data model.")
+		return
+	}
+	if data.Token.IsNull() {
+		resp.Diagnostics.AddError("Missing Credentials", "The provider's token, or client_id and client_secret, must be set to use GoodAccess resources and data sources.")
+		return
+	}
+
+	r.client = data.client
+	r.token = data.Token.ValueString()
+}
+
+func (r *RelationACSListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists access card ↔ system relations, optionally only those of one access card or system.",
+		Attributes: map[string]schema.Attribute{
+			"access_card_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list relations of this access card.",
+			},
+			"system_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list relations of this system.",
+			},
+		},
+	}
+}
+
+func (r *RelationACSListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config RelationACSListModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	relations, err := fetchRelations(r.client, r.token)
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Failed to fetch relations list: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for _, rel := range relations {
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			if !config.AccessCardID.IsNull() && rel.AccessCardID != config.AccessCardID.ValueString() {
+				continue
+			}
+			if !config.SystemID.IsNull() && rel.SystemID != config.SystemID.ValueString() {
+				continue
+			}
+
+			data := RelationACSTFModel{
+				ID:           types.StringValue(relationID(rel.AccessCardID, rel.SystemID)),
+				AccessCardID: types.StringValue(rel.AccessCardID),
+				SystemID:     types.StringValue(rel.SystemID),
+				Expired:      types.BoolValue(false),
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = data.ID.ValueString()
+			result.Diagnostics.Append(result.Identity.Set(ctx, data.identity())...)
+			if req.IncludeResource {
+				result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+			}
+
+			count++
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
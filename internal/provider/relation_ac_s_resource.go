@@ -3,23 +3,56 @@
 package provider
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"golang.org/x/net/context"
 	"io"
 	"net/http"
-	"net/http/httputil"
+	"strings"
+	"time"
 )
 
 type RelationACSTFModel struct {
-	ID           types.String `tfsdk:"id"`
+	ID             types.String `tfsdk:"id"`
+	AccessCardID   types.String `tfsdk:"access_card_id"`
+	SystemID       types.String `tfsdk:"system_id"`
+	AccessCardName types.String `tfsdk:"access_card_name"`
+	SystemName     types.String `tfsdk:"system_name"`
+	ExpiresAt      types.String `tfsdk:"expires_at"`
+	Expired        types.Bool   `tfsdk:"expired"`
+}
+
+// expiryWarningWindow is how long before expires_at a plan starts warning
+// about the upcoming revocation.
+const expiryWarningWindow = 7 * 24 * time.Hour
+
+var (
+	_ resource.ResourceWithModifyPlan       = &RelationACSResource{}
+	_ resource.ResourceWithConfigValidators = &RelationACSResource{}
+	_ resource.ResourceWithIdentity         = &RelationACSResource{}
+	_ resource.ResourceWithImportState      = &RelationACSResource{}
+)
+
+// relationIdentityModel identifies a goodaccess_relation_ac_s by the access
+// card and system it connects.
+type relationIdentityModel struct {
 	AccessCardID types.String `tfsdk:"access_card_id"`
 	SystemID     types.String `tfsdk:"system_id"`
 }
 
+// identity returns the resource identity of m.
+func (m RelationACSTFModel) identity() relationIdentityModel {
+	return relationIdentityModel{AccessCardID: m.AccessCardID, SystemID: m.SystemID}
+}
+
 func NewRelationACSResource() resource.Resource {
 	return &RelationACSResource{
 		client: &http.Client{},
@@ -28,20 +61,101 @@ func NewRelationACSResource() resource.Resource {
 }
 
 type RelationACSResource struct {
-	client *http.Client
-	token  string
+	client   *http.Client
+	token    string
+	readOnly bool
 }
 
 func (r *RelationACSResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Description:        "Assigns a system to an access card. Do not use it for an access card that sets `system_ids`; the two would fight over the same relations.",
+		DeprecationMessage: "goodaccess_relation_ac_s has been renamed to goodaccess_access_card_system. Switch to the new name and add a `moved` block to keep the existing relations.",
 		Attributes: map[string]schema.Attribute{
-			"id":             schema.StringAttribute{Computed: true},
-			"access_card_id": schema.StringAttribute{Required: true},
-			"system_id":      schema.StringAttribute{Required: true},
+			"id": schema.StringAttribute{Computed: true},
+			"access_card_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the access card. Exactly one of `access_card_id` and `access_card_name` must be set.",
+			},
+			"system_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the system. Exactly one of `system_id` and `system_name` must be set.",
+			},
+			"access_card_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of an existing access card, resolved to `access_card_id` at plan time.",
+			},
+			"system_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of an existing system, resolved to `system_id` at plan time.",
+			},
+			"expires_at": schema.StringAttribute{
+				Optional:    true,
+				Description: "RFC3339 timestamp after which access is revoked. Once it has passed, the next apply deletes the relation in GoodAccess while the resource stays in state as `expired`. Move it into the future to grant access again.",
+				Validators:  []validator.String{rfc3339Validator{}},
+			},
+			"expired": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether `expires_at` has passed and the relation has been revoked.",
+			},
 		},
 	}
 }
 
+func (r *RelationACSResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"access_card_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "ID of the access card.",
+			},
+			"system_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "ID of the system.",
+			},
+		},
+	}
+}
+
+// ImportState imports a relation either from an `<access_card_id>:<system_id>`
+// import ID or from its identity.
+func (r *RelationACSResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity relationIdentityModel
+	if req.ID != "" {
+		accessCardID, systemID, err := parseRelationID(req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+			return
+		}
+		identity = relationIdentityModel{
+			AccessCardID: types.StringValue(accessCardID),
+			SystemID:     types.StringValue(systemID),
+		}
+	} else {
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	data := RelationACSTFModel{
+		ID:           types.StringValue(relationID(identity.AccessCardID.ValueString(), identity.SystemID.ValueString())),
+		AccessCardID: identity.AccessCardID,
+		SystemID:     identity.SystemID,
+		Expired:      types.BoolValue(false),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+}
+
+func (r *RelationACSResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		exactlyOneOf("access_card_id", "access_card_name"),
+		exactlyOneOf("system_id", "system_name"),
+	}
+}
+
 func (r *RelationACSResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	data, ok := req.ProviderData.(goodAccessProviderModel)
 	// Prevent panic if the provider has not been configured.
@@ -49,16 +163,28 @@ func (r *RelationACSResource) Configure(ctx context.Context, req resource.Config
 		return
 	}
 
-	if !ok || data.Token.IsNull() {
+	if !ok {
 		resp.Diagnostics.AddError("Configuration Error", "Failed to retrieve provider data model.")
 		return
 	}
+	r.readOnly = data.ReadOnly.ValueBool()
 
+	// Credentials that are not known until apply leave the resource
+	// without a client for now; read_only still applies to the plan.
+	if data.client == nil {
+		return
+	}
+	if data.Token.IsNull() {
+		resp.Diagnostics.AddError("Missing Credentials", "The provider's token, or client_id and client_secret, must be set to use GoodAccess resources and data sources.")
+		return
+	}
+
+	r.client = data.client
 	r.token = data.Token.ValueString()
 }
 
 func (r *RelationACSResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = "goodaccess_relation_ac_s"
+	resp.TypeName = relationACSTypeName
 }
 
 func (r *RelationACSResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -69,42 +195,38 @@ func (r *RelationACSResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	url := fmt.Sprintf("https://integration.goodaccess.com/api/v1/relation/access-card/%s/system/%s",
-		data.AccessCardID.ValueString(), data.SystemID.ValueString())
-
-	httpReq, err := http.NewRequest("POST", url, nil)
-	if err != nil {
-		resp.Diagnostics.AddError("Request Error", fmt.Sprintf("Failed to create POST request: %s", err))
+	// Names are normally resolved at plan time, unless the provider was not
+	// configured yet.
+	r.resolveNames(&data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	httpReq.Header.Add("Authorization", "Bearer "+r.token)
-	httpReq.Header.Add("Accept", "*/*")
 
-	dump, err := httputil.DumpRequestOut(httpReq, true)
-	if err != nil {
-		fmt.Printf("DEBUG: Could not dump request: %s\n", err)
-	} else {
-		fmt.Printf("DEBUG: Outgoing HTTP request:\n%s\n", dump)
-	}
-
-	httpResp, err := r.client.Do(httpReq)
-	if err != nil {
-		resp.Diagnostics.AddError("Network Error", fmt.Sprintf("POST request failed: %s", err))
+	// Synthetic ID: access_card_id + system_id
+	data.ID = types.StringValue(relationID(data.AccessCardID.ValueString(), data.SystemID.ValueString()))
+
+	data.Expired = types.BoolValue(relationExpired(data.ExpiresAt, time.Now()))
+	if data.Expired.ValueBool() {
+		resp.Diagnostics.AddWarning(
+			"Access Already Expired",
+			fmt.Sprintf("Relation %s expired at %s and was not created.", data.ID.ValueString(), data.ExpiresAt.ValueString()),
+		)
+		diags = resp.State.Set(ctx, &data)
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 		return
 	}
-	defer httpResp.Body.Close()
 
-	if httpResp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(httpResp.Body)
-		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Create failed: %d: %s", httpResp.StatusCode, bodyBytes))
+	// The expiry is passed on for API versions that support expiring
+	// relations; expired relations are revoked by the provider either way.
+	if err := createRelation(r.client, r.token, data.AccessCardID.ValueString(), data.SystemID.ValueString(), data.ExpiresAt.ValueString()); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to create relation: %s", err))
 		return
 	}
 
-	// Synthetic ID: access_card_id + system_id
-	data.ID = types.StringValue(fmt.Sprintf("%s:%s", data.AccessCardID.ValueString(), data.SystemID.ValueString()))
-
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.identity())...)
 }
 
 func (r *RelationACSResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -115,39 +237,240 @@ func (r *RelationACSResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	httpReq, _ := http.NewRequest("GET", "https://integration.goodaccess.com/api/v1/relations", nil)
-	httpReq.Header.Add("Authorization", "Bearer "+r.token)
-	httpReq.Header.Add("Accept", "*/*")
-
-	httpResp, err := r.client.Do(httpReq)
-	if err != nil || httpResp.StatusCode != http.StatusOK {
-		resp.Diagnostics.AddError("API Error", "Failed to fetch relations list")
+	results, err := fetchRelations(r.client, r.token)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to fetch relations list: %s", err))
 		return
 	}
-	defer httpResp.Body.Close()
-
-	bodyBytes, _ := io.ReadAll(httpResp.Body)
-	var results []struct {
-		ID           string `json:"id"`
-		AccessCardID string `json:"accessCardId"`
-		SystemID     string `json:"systemId"`
-	}
-	_ = json.Unmarshal(bodyBytes, &results)
 
 	found := false
 	for _, rel := range results {
 		if rel.AccessCardID == state.AccessCardID.ValueString() && rel.SystemID == state.SystemID.ValueString() {
-			state.ID = types.StringValue(fmt.Sprintf("%s:%s", rel.AccessCardID, rel.SystemID))
+			state.ID = types.StringValue(relationID(rel.AccessCardID, rel.SystemID))
 			found = true
 			break
 		}
 	}
+	if !found && (state.Expired.ValueBool() || relationExpired(state.ExpiresAt, time.Now())) {
+		// Revoked on expiry, by the provider or by the API itself; keep
+		// tracking it so it is not created again.
+		state.Expired = types.BoolValue(true)
+		diags = resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
+		return
+	}
 	if !found {
 		resp.State.RemoveResource(ctx)
 		return
 	}
 
+	// Someone granted the access again; the next plan revokes it if it is
+	// still expired.
+	state.Expired = types.BoolValue(false)
+
 	_ = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
+}
+
+// ModifyPlan resolves access card and system names to IDs and verifies that
+// referenced IDs exist, so a typo or a stale reference fails the plan instead
+// of a half-finished apply.
+func (r *RelationACSResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Checked last, against the plan as modified below.
+	defer rejectChangesIfReadOnly(r.readOnly, req, resp)
+
+	// Nothing to check on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan RelationACSTFModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state RelationACSTFModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	r.planExpiry(ctx, &plan, state, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The remaining checks need the API. Without it only IDs set in the
+	// configuration can be compared; Update catches moves by name.
+	if r.token == "" {
+		requireReplaceOnMove(req, plan, state, resp)
+		return
+	}
+
+	r.resolveNames(&plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("access_card_id"), plan.AccessCardID)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("system_id"), plan.SystemID)...)
+
+	requireReplaceOnMove(req, plan, state, resp)
+
+	if plan.AccessCardName.IsNull() && !plan.AccessCardID.IsUnknown() && !plan.AccessCardID.Equal(state.AccessCardID) {
+		card, err := fetchAccessCard(r.client, r.token, plan.AccessCardID.ValueString())
+		switch {
+		case err != nil:
+			resp.Diagnostics.AddWarning("Reference Check Skipped", fmt.Sprintf("Could not look up access card: %s", err))
+		case card == nil:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("access_card_id"),
+				"Access Card Not Found",
+				fmt.Sprintf("No access card with ID %q exists in GoodAccess.", plan.AccessCardID.ValueString()),
+			)
+		}
+	}
+
+	if plan.SystemName.IsNull() && !plan.SystemID.IsUnknown() && !plan.SystemID.Equal(state.SystemID) {
+		systems, err := fetchSystems(r.client, r.token)
+		if err != nil {
+			resp.Diagnostics.AddWarning("Reference Check Skipped", fmt.Sprintf("Could not list systems: %s", err))
+			return
+		}
+
+		found := false
+		for _, s := range systems {
+			if s.ID == plan.SystemID.ValueString() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("system_id"),
+				"System Not Found",
+				fmt.Sprintf("No system with ID %q exists in GoodAccess.", plan.SystemID.ValueString()),
+			)
+		}
+	}
+}
+
+// requireReplaceOnMove replaces the relation when it points to another access
+// card or system, as relations cannot be updated. IDs that are not known yet
+// are left alone.
+func requireReplaceOnMove(req resource.ModifyPlanRequest, plan, state RelationACSTFModel, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+	if !plan.AccessCardID.IsUnknown() && !plan.AccessCardID.Equal(state.AccessCardID) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("access_card_id"))
+	}
+	if !plan.SystemID.IsUnknown() && !plan.SystemID.Equal(state.SystemID) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("system_id"))
+	}
+}
+
+// planExpiry works out whether the relation has expired and reports upcoming
+// and due revocations as warnings.
+func (r *RelationACSResource) planExpiry(ctx context.Context, plan *RelationACSTFModel, state RelationACSTFModel, resp *resource.ModifyPlanResponse) {
+	if plan.ExpiresAt.IsUnknown() {
+		plan.Expired = types.BoolUnknown()
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expired"), plan.Expired)...)
+		return
+	}
+
+	now := time.Now()
+	plan.Expired = types.BoolValue(relationExpired(plan.ExpiresAt, now))
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expired"), plan.Expired)...)
+	if plan.ExpiresAt.IsNull() {
+		return
+	}
+
+	expiresAt, _ := time.Parse(time.RFC3339, plan.ExpiresAt.ValueString())
+	switch {
+	case plan.Expired.ValueBool() && !state.Expired.ValueBool():
+		resp.Diagnostics.AddWarning(
+			"Access Expired",
+			fmt.Sprintf("Access of card %s to system %s expired at %s and will be revoked by this apply.",
+				plan.AccessCardID.ValueString(), plan.SystemID.ValueString(), plan.ExpiresAt.ValueString()),
+		)
+	case !plan.Expired.ValueBool() && expiresAt.Sub(now) < expiryWarningWindow:
+		resp.Diagnostics.AddWarning(
+			"Access Expiring Soon",
+			fmt.Sprintf("Access of card %s to system %s expires at %s, in %s.",
+				plan.AccessCardID.ValueString(), plan.SystemID.ValueString(), plan.ExpiresAt.ValueString(), expiresAt.Sub(now).Round(time.Minute)),
+		)
+	}
+}
+
+// relationExpired reports whether a valid expires_at lies at or before now.
+func relationExpired(expiresAt types.String, now time.Time) bool {
+	if expiresAt.IsNull() || expiresAt.IsUnknown() {
+		return false
+	}
+	t, err := time.Parse(time.RFC3339, expiresAt.ValueString())
+	return err == nil && !now.Before(t)
+}
+
+// resolveNames fills in access_card_id and system_id from access_card_name
+// and system_name. A name must match exactly one object.
+func (r *RelationACSResource) resolveNames(data *RelationACSTFModel, diags *diag.Diagnostics) {
+	if !data.AccessCardName.IsNull() && !data.AccessCardName.IsUnknown() {
+		cards, err := fetchAccessCards(r.client, r.token)
+		if err != nil {
+			diags.AddAttributeError(path.Root("access_card_name"), "API Error", fmt.Sprintf("Failed to list access cards: %s", err))
+			return
+		}
+
+		var ids []string
+		for _, c := range cards {
+			if c.Name == data.AccessCardName.ValueString() {
+				ids = append(ids, c.ID)
+			}
+		}
+		if id, ok := resolveName(diags, path.Root("access_card_name"), "access card", data.AccessCardName.ValueString(), ids); ok {
+			data.AccessCardID = types.StringValue(id)
+		}
+	}
+
+	if !data.SystemName.IsNull() && !data.SystemName.IsUnknown() {
+		systems, err := fetchSystems(r.client, r.token)
+		if err != nil {
+			diags.AddAttributeError(path.Root("system_name"), "API Error", fmt.Sprintf("Failed to list systems: %s", err))
+			return
+		}
+
+		var ids []string
+		for _, s := range systems {
+			if s.Name == data.SystemName.ValueString() {
+				ids = append(ids, s.ID)
+			}
+		}
+		if id, ok := resolveName(diags, path.Root("system_name"), "system", data.SystemName.ValueString(), ids); ok {
+			data.SystemID = types.StringValue(id)
+		}
+	}
+}
+
+// resolveName picks the only ID matching a name, reporting missing and
+// ambiguous names against attr.
+func resolveName(diags *diag.Diagnostics, attr path.Path, kind, name string, ids []string) (string, bool) {
+	switch len(ids) {
+	case 1:
+		return ids[0], true
+	case 0:
+		diags.AddAttributeError(attr, "Name Not Found", fmt.Sprintf("No %s named %q exists in GoodAccess.", kind, name))
+	default:
+		diags.AddAttributeError(
+			attr,
+			"Ambiguous Name",
+			fmt.Sprintf("%d %ss are named %q (IDs %s). Use the ID instead.", len(ids), kind, name, strings.Join(ids, ", ")),
+		)
+	}
+	return "", false
 }
 
 func (r *RelationACSResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -159,24 +482,11 @@ func (r *RelationACSResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 
 	// Fetch relation ID first
-	httpReq, _ := http.NewRequest("GET", "https://integration.goodaccess.com/api/v1/relations", nil)
-	httpReq.Header.Add("Authorization", "Bearer "+r.token)
-	httpReq.Header.Add("Accept", "*/*")
-
-	httpResp, err := r.client.Do(httpReq)
+	relations, err := fetchRelations(r.client, r.token)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error", "Failed to lookup relation")
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to lookup relation: %s", err))
 		return
 	}
-	defer httpResp.Body.Close()
-
-	bodyBytes, _ := io.ReadAll(httpResp.Body)
-	var relations []struct {
-		ID           string `json:"id"`
-		AccessCardID string `json:"accessCardId"`
-		SystemID     string `json:"systemId"`
-	}
-	_ = json.Unmarshal(bodyBytes, &relations)
 
 	var relationID string
 	for _, rel := range relations {
@@ -191,24 +501,194 @@ func (r *RelationACSResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	// Send DELETE
-	url := fmt.Sprintf("https://integration.goodaccess.com/api/v1/relation/%s", relationID)
-	httpReq, _ = http.NewRequest("DELETE", url, nil)
-	httpReq.Header.Add("Authorization", "Bearer "+r.token)
-
-	httpResp, err = r.client.Do(httpReq)
-	if err != nil || httpResp.StatusCode != http.StatusOK {
-		resp.Diagnostics.AddError("API Error", "Failed to delete relation")
+	if err := deleteRelation(r.client, r.token, relationID); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to delete relation: %s", err))
 		return
 	}
 }
 
+// Update handles changes that keep the same access card and system, i.e. the
+// expiry. Any other change replaces the relation.
 func (r *RelationACSResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Relations are immutable in GoodAccess API.
-	// Instead of updating, Terraform should replace the resource.
+	var plan, state RelationACSTFModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	resp.Diagnostics.AddWarning(
-		"Update Not Supported",
-		"GoodAccess access-card↔system relations cannot be updated. Terraform will recreate the resource if changes are made.",
-	)
+	// ModifyPlan replaces moved relations, but could not resolve names if
+	// the provider was not configured at plan time.
+	r.resolveNames(&plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !plan.AccessCardID.Equal(state.AccessCardID) || !plan.SystemID.Equal(state.SystemID) {
+		resp.Diagnostics.AddError(
+			"Relation Cannot Be Moved",
+			fmt.Sprintf("The relation now points to access card %s and system %s instead of %s and %s. Relations cannot be changed in place, and the replacement could not be planned because the provider was not configured at plan time. Run the plan again to replace the relation.",
+				plan.AccessCardID.ValueString(), plan.SystemID.ValueString(), state.AccessCardID.ValueString(), state.SystemID.ValueString()),
+		)
+		return
+	}
+
+	switch {
+	case plan.Expired.ValueBool() && !state.Expired.ValueBool():
+		_, err := detachRelations(r.client, r.token, func(rel relationAPIModel) bool {
+			return rel.AccessCardID == state.AccessCardID.ValueString() && rel.SystemID == state.SystemID.ValueString()
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to revoke expired relation: %s", err))
+			return
+		}
+	case !plan.Expired.ValueBool() && state.Expired.ValueBool():
+		if err := createRelation(r.client, r.token, plan.AccessCardID.ValueString(), plan.SystemID.ValueString(), plan.ExpiresAt.ValueString()); err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to restore relation: %s", err))
+			return
+		}
+	case !plan.Expired.ValueBool() && !plan.ExpiresAt.Equal(state.ExpiresAt):
+		// The API only takes the expiry on create, so the relation is
+		// recreated for it to revoke access at the new time.
+		_, err := detachRelations(r.client, r.token, func(rel relationAPIModel) bool {
+			return rel.AccessCardID == state.AccessCardID.ValueString() && rel.SystemID == state.SystemID.ValueString()
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to change expiry of relation: %s", err))
+			return
+		}
+		if err := createRelation(r.client, r.token, plan.AccessCardID.ValueString(), plan.SystemID.ValueString(), plan.ExpiresAt.ValueString()); err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to change expiry of relation: %s", err))
+			return
+		}
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, plan.identity())...)
+}
+
+// relationID builds the ID of a relation from its access card and system.
+func relationID(accessCardID, systemID string) string {
+	return accessCardID + ":" + systemID
+}
+
+// parseRelationID splits a relation ID into its access card and system IDs.
+func parseRelationID(id string) (string, string, error) {
+	accessCardID, systemID, ok := strings.Cut(id, ":")
+	if !ok || accessCardID == "" || systemID == "" || strings.Contains(systemID, ":") {
+		return "", "", fmt.Errorf("%q is not a relation ID, expected <access_card_id>:<system_id>", id)
+	}
+	return accessCardID, systemID, nil
+}
+
+// relationAPIModel is an access card ↔ system relation as returned by the
+// GoodAccess /relations endpoint.
+type relationAPIModel struct {
+	ID           string `json:"id"`
+	AccessCardID string `json:"accessCardId"`
+	SystemID     string `json:"systemId"`
+}
+
+// fetchRelations lists all access card ↔ system relations visible to the token.
+func fetchRelations(client *http.Client, token string) ([]relationAPIModel, error) {
+	httpReq, err := http.NewRequest("GET", "https://integration.goodaccess.com/api/v1/relations", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Add("Authorization", "Bearer "+token)
+	httpReq.Header.Add("Accept", "*/*")
+
+	httpResp, err := client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("GET request failed: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	bodyBytes, _ := io.ReadAll(httpResp.Body)
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("status %d: %s", httpResp.StatusCode, string(bodyBytes))
+	}
+
+	var relations []relationAPIModel
+	if err := json.Unmarshal(bodyBytes, &relations); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	return relations, nil
+}
+
+// createRelation assigns a system to an access card. A non-empty expiresAt
+// is passed on for API versions that support expiring relations.
+func createRelation(client *http.Client, token, accessCardID, systemID, expiresAt string) error {
+	url := fmt.Sprintf("https://integration.goodaccess.com/api/v1/relation/access-card/%s/system/%s", accessCardID, systemID)
+
+	var body io.Reader
+	if expiresAt != "" {
+		payload, _ := json.Marshal(map[string]string{"expiresAt": expiresAt})
+		body = bytes.NewBuffer(payload)
+	}
+
+	httpReq, err := http.NewRequest("POST", url, body)
+	if err != nil {
+		return fmt.Errorf("failed to create POST request: %w", err)
+	}
+	httpReq.Header.Add("Authorization", "Bearer "+token)
+	httpReq.Header.Add("Accept", "*/*")
+	if body != nil {
+		httpReq.Header.Add("Content-Type", "application/json")
+	}
+
+	httpResp, err := client.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("POST request failed: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(httpResp.Body)
+		return fmt.Errorf("status %d: %s", httpResp.StatusCode, string(bodyBytes))
+	}
+	return nil
+}
+
+// deleteRelation removes a relation by its GoodAccess ID.
+func deleteRelation(client *http.Client, token, relationID string) error {
+	url := fmt.Sprintf("https://integration.goodaccess.com/api/v1/relation/%s", relationID)
+	httpReq, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create DELETE request: %w", err)
+	}
+	httpReq.Header.Add("Authorization", "Bearer "+token)
+
+	httpResp, err := client.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("DELETE request failed: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(httpResp.Body)
+		return fmt.Errorf("status %d: %s", httpResp.StatusCode, string(bodyBytes))
+	}
+	return nil
+}
+
+// detachRelations deletes every relation for which match returns true and
+// returns the relations it removed. It stops at the first failure.
+func detachRelations(client *http.Client, token string, match func(relationAPIModel) bool) ([]relationAPIModel, error) {
+	relations, err := fetchRelations(client, token)
+	if err != nil {
+		return nil, err
+	}
+
+	var removed []relationAPIModel
+	for _, rel := range relations {
+		if !match(rel) {
+			continue
+		}
+		if err := deleteRelation(client, token, rel.ID); err != nil {
+			return removed, fmt.Errorf("relation %s: %w", rel.ID, err)
+		}
+		removed = append(removed, rel)
+	}
+	return removed, nil
 }
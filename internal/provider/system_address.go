@@ -0,0 +1,236 @@
+// Copyright (c) KRUKON s.r.o
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/net/idna"
+	"net/netip"
+	"strings"
+)
+
+// Address types supported by goodaccess_system.
+const (
+	addressTypeHost    = "host"
+	addressTypeIP      = "ip"
+	addressTypeCIDR    = "cidr"
+	addressTypeIPRange = "ip_range"
+	addressTypeDomain  = "domain"
+)
+
+var addressTypes = []string{addressTypeHost, addressTypeIP, addressTypeCIDR, addressTypeIPRange, addressTypeDomain}
+
+// addressAttribute maps each address type to the attribute that holds it.
+var addressAttribute = map[string]string{
+	addressTypeHost:    "host",
+	addressTypeIP:      "host",
+	addressTypeCIDR:    "cidr",
+	addressTypeIPRange: "ip_range",
+	addressTypeDomain:  "host",
+}
+
+// parseCIDR parses an IPv4 or IPv6 CIDR block and rejects prefixes with host
+// bits set, e.g. 10.0.0.1/8.
+func parseCIDR(s string) (netip.Prefix, error) {
+	p, err := netip.ParsePrefix(s)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("%q is not a valid CIDR block", s)
+	}
+	if p.Masked() != p {
+		return netip.Prefix{}, fmt.Errorf("%q has host bits set, did you mean %q?", s, p.Masked().String())
+	}
+	return p, nil
+}
+
+// parseIPRange parses a range written as "<first>-<last>". Both ends must be
+// of the same address family and in ascending order.
+func parseIPRange(s string) (netip.Addr, netip.Addr, error) {
+	first, last, ok := strings.Cut(s, "-")
+	if !ok {
+		return netip.Addr{}, netip.Addr{}, fmt.Errorf("%q is not a range, expected <first>-<last>", s)
+	}
+
+	from, err := netip.ParseAddr(strings.TrimSpace(first))
+	if err != nil {
+		return netip.Addr{}, netip.Addr{}, fmt.Errorf("%q is not a valid IP address", first)
+	}
+	to, err := netip.ParseAddr(strings.TrimSpace(last))
+	if err != nil {
+		return netip.Addr{}, netip.Addr{}, fmt.Errorf("%q is not a valid IP address", last)
+	}
+
+	if from.Is4() != to.Is4() {
+		return netip.Addr{}, netip.Addr{}, fmt.Errorf("%q mixes IPv4 and IPv6 addresses", s)
+	}
+	if to.Less(from) {
+		return netip.Addr{}, netip.Addr{}, fmt.Errorf("%q ends before it starts", s)
+	}
+	return from, to, nil
+}
+
+// normalizeDomain validates a domain name, optionally prefixed with a "*."
+// wildcard label, and returns it in lower-case ASCII form with international
+// labels converted to punycode and any trailing dot removed.
+func normalizeDomain(s string) (string, error) {
+	name := strings.TrimSuffix(strings.TrimSpace(s), ".")
+
+	wildcard := strings.HasPrefix(name, "*.")
+	if wildcard {
+		name = strings.TrimPrefix(name, "*.")
+	}
+	if strings.Contains(name, "*") {
+		return "", fmt.Errorf("%q: a wildcard is only allowed as the whole leftmost label, e.g. *.example.com", s)
+	}
+
+	ascii, err := idna.Lookup.ToASCII(name)
+	if err != nil {
+		return "", fmt.Errorf("%q is not a valid domain name: %s", s, err)
+	}
+	if !strings.Contains(ascii, ".") {
+		return "", fmt.Errorf("%q must have at least two labels", s)
+	}
+
+	ascii = strings.ToLower(ascii)
+	if wildcard {
+		ascii = "*." + ascii
+	}
+	return ascii, nil
+}
+
+// normalizeHost returns an IP address in its canonical form, or a domain name
+// as normalised by normalizeDomain.
+func normalizeHost(s string) (string, error) {
+	if addr, err := netip.ParseAddr(strings.TrimSpace(s)); err == nil {
+		return addr.String(), nil
+	}
+	return normalizeDomain(s)
+}
+
+// domainMatches reports whether hostname is covered by a domain pattern. A
+// "*." wildcard matches exactly one additional label, so *.example.com covers
+// www.example.com but neither example.com nor a.b.example.com.
+func domainMatches(pattern, hostname string) bool {
+	p, errP := normalizeDomain(pattern)
+	h, errH := normalizeDomain(hostname)
+	if errP != nil || errH != nil {
+		return strings.EqualFold(pattern, hostname)
+	}
+
+	suffix, wildcard := strings.CutPrefix(p, "*.")
+	if !wildcard {
+		return p == h
+	}
+	label, rest, ok := strings.Cut(h, ".")
+	return ok && label != "" && rest == suffix
+}
+
+// sameAddress reports whether two addresses of the given type are equal
+// after normalisation, so the API rewriting e.g. an IPv6 CIDR does not show up
+// as drift.
+func sameAddress(addressType, a, b string) bool {
+	switch addressType {
+	case addressTypeIP:
+		x, errX := netip.ParseAddr(a)
+		y, errY := netip.ParseAddr(b)
+		return errX == nil && errY == nil && x == y
+	case addressTypeCIDR:
+		x, errX := netip.ParsePrefix(a)
+		y, errY := netip.ParsePrefix(b)
+		return errX == nil && errY == nil && x == y
+	case addressTypeIPRange:
+		x1, x2, errX := parseIPRange(a)
+		y1, y2, errY := parseIPRange(b)
+		return errX == nil && errY == nil && x1 == y1 && x2 == y2
+	case addressTypeDomain:
+		x, errX := normalizeDomain(a)
+		y, errY := normalizeDomain(b)
+		return errX == nil && errY == nil && x == y
+	}
+	return a == b
+}
+
+// systemAddressValidator checks that exactly the attribute matching
+// `address_type` is set and that it holds a valid address.
+type systemAddressValidator struct{}
+
+var _ resource.ConfigValidator = systemAddressValidator{}
+
+func (v systemAddressValidator) Description(_ context.Context) string {
+	return "The attribute selected by address_type must be set to a valid address, and the others must be unset."
+}
+
+func (v systemAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v systemAddressValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var addressType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("address_type"), &addressType)...)
+	if resp.Diagnostics.HasError() || addressType.IsUnknown() {
+		return
+	}
+
+	kind := addressTypeHost
+	if !addressType.IsNull() {
+		kind = addressType.ValueString()
+	}
+	want, ok := addressAttribute[kind]
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("address_type"),
+			"Invalid Address Type",
+			fmt.Sprintf("address_type must be one of %s, got %q.", strings.Join(addressTypes, ", "), kind),
+		)
+		return
+	}
+
+	for _, name := range []string{"host", "cidr", "ip_range"} {
+		var value types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &value)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if name != want {
+			if !value.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(name),
+					"Conflicting Address",
+					fmt.Sprintf("%s cannot be used with address_type %q, use %s instead.", name, kind, want),
+				)
+			}
+			continue
+		}
+
+		if value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing Address",
+				fmt.Sprintf("%s is required when address_type is %q.", name, kind),
+			)
+			continue
+		}
+		if value.IsUnknown() {
+			continue
+		}
+
+		var err error
+		switch kind {
+		case addressTypeIP:
+			_, err = netip.ParseAddr(value.ValueString())
+		case addressTypeCIDR:
+			_, err = parseCIDR(value.ValueString())
+		case addressTypeIPRange:
+			_, _, err = parseIPRange(value.ValueString())
+		case addressTypeDomain:
+			_, err = normalizeDomain(value.ValueString())
+		}
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid Address", err.Error())
+		}
+	}
+}
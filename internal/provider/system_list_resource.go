@@ -0,0 +1,137 @@
+// Copyright (c) KRUKON s.r.o
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/http"
+)
+
+type SystemListModel struct {
+	Name types.String `tfsdk:"name"`
+	Tags types.Map    `tfsdk:"tags"`
+}
+
+var _ list.ListResourceWithConfigure = &SystemListResource{}
+
+func NewSystemListResource() list.ListResource {
+	return &SystemListResource{
+		client: &http.Client{},
+		token:  "",
+	}
+}
+
+// SystemListResource lists existing systems for `terraform query`.
+type SystemListResource struct {
+	client             *http.Client
+	token              string
+	deletionProtection bool
+	defaultTags        map[string]string
+}
+
+func (r *SystemListResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "goodaccess_system"
+}
+
+func (r *SystemListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	data, ok := req.ProviderData.(goodAccessProviderModel)
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	if !ok {
+		resp.Diagnostics.AddError("Configuration Error", "Failed to retrieve provider data model.")
+		return
+	}
+	if data.Token.IsNull() {
+		resp.Diagnostics.AddError("Missing Credentials", "The provider's token, or client_id and client_secret, must be set to use GoodAccess resources and data sources.")
+		return
+	}
+
+	r.client = data.client
+	r.token = data.Token.ValueString()
+	r.deletionProtection = data.DeletionProtection.ValueBool()
+
+	defaultTags, diags := providerDefaultTags(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	r.defaultTags = defaultTags
+}
+
+func (r *SystemListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists GoodAccess systems, optionally filtered by name and tags.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list systems with exactly this name.",
+			},
+			"tags": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Only list systems that have all of these tags.",
+			},
+		},
+	}
+}
+
+func (r *SystemListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config SystemListModel
+	diags := req.Config.Get(ctx, &config)
+
+	filter := map[string]string{}
+	if !diags.HasError() && !config.Tags.IsNull() {
+		diags.Append(config.Tags.ElementsAs(ctx, &filter, false)...)
+	}
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	systems, err := fetchSystems(r.client, r.token)
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Failed to read system list: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for _, s := range systems {
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			if !config.Name.IsNull() && s.Name != config.Name.ValueString() {
+				continue
+			}
+			if !tagsMatch(s.Labels, filter) {
+				continue
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = s.Name
+			result.Diagnostics.Append(result.Identity.Set(ctx, systemIdentityModel{ID: types.StringValue(s.ID)})...)
+
+			if req.IncludeResource {
+				data := SystemModel{
+					DeletionProtection: types.BoolValue(r.deletionProtection),
+					Ports:              types.SetNull(types.ObjectType{AttrTypes: systemPortAttrTypes}),
+					Tags:               types.MapNull(types.StringType),
+					TagsAll:            types.MapNull(types.StringType),
+				}
+				result.Diagnostics.Append(data.fromAPI(ctx, s, r.defaultTags)...)
+				result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+			}
+
+			count++
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,271 @@
+// Copyright (c) KRUKON s.r.o
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+	"strings"
+)
+
+// validatePort checks that n is a usable TCP/UDP port number.
+func validatePort(n int64) error {
+	if n < 1 || n > 65535 {
+		return fmt.Errorf("port must be between 1 and 65535, got %d", n)
+	}
+	return nil
+}
+
+// validatePortRange checks that from-to is a usable, ascending port range.
+func validatePortRange(from, to int64) error {
+	if validatePort(from) != nil || validatePort(to) != nil {
+		return fmt.Errorf("ports must be between 1 and 65535, got %d-%d", from, to)
+	}
+	if from > to {
+		return fmt.Errorf("from_port (%d) must not be greater than to_port (%d)", from, to)
+	}
+	return nil
+}
+
+// parsePortSpec parses a single port, e.g. "443", or a range such as
+// "8000-8100".
+func parsePortSpec(spec string) (int64, int64, error) {
+	first, last, isRange := strings.Cut(strings.TrimSpace(spec), "-")
+	from, err := strconv.ParseInt(strings.TrimSpace(first), 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%q is not a port or port range", spec)
+	}
+	to := from
+	if isRange {
+		to, err = strconv.ParseInt(strings.TrimSpace(last), 10, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("%q is not a port or port range", spec)
+		}
+	}
+	if err := validatePortRange(from, to); err != nil {
+		return 0, 0, err
+	}
+	return from, to, nil
+}
+
+// parsePort parses a port as stored in older states or returned by the API.
+// Surrounding whitespace is ignored and an empty string yields a null value.
+func parsePort(s string) (types.Int64, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return types.Int64Null(), nil
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return types.Int64Null(), fmt.Errorf("%q is not a port number", s)
+	}
+	if err := validatePort(n); err != nil {
+		return types.Int64Null(), err
+	}
+	return types.Int64Value(n), nil
+}
+
+// apiPort is a port as returned by the API, which may encode it either as a
+// JSON number or as a string.
+type apiPort string
+
+func (p *apiPort) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*p = apiPort(s)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(b, &n); err != nil {
+		return fmt.Errorf("port: expected string or number, got %s", string(b))
+	}
+	*p = apiPort(n.String())
+	return nil
+}
+
+// matches reports whether the API port equals the configured one.
+func (p apiPort) matches(port types.Int64) bool {
+	v, err := parsePort(string(p))
+	return err == nil && v.Equal(port)
+}
+
+// portValidator rejects port numbers outside 1-65535.
+type portValidator struct{}
+
+var _ validator.Int64 = portValidator{}
+
+func (v portValidator) Description(_ context.Context) string {
+	return "value must be a port number between 1 and 65535"
+}
+
+func (v portValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v portValidator) ValidateInt64(_ context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if err := validatePort(req.ConfigValue.ValueInt64()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Port", err.Error())
+	}
+}
+
+// SystemPortModel is a single entry of the `ports` block of goodaccess_system.
+type SystemPortModel struct {
+	Protocol types.String `tfsdk:"protocol"`
+	FromPort types.Int64  `tfsdk:"from_port"`
+	ToPort   types.Int64  `tfsdk:"to_port"`
+}
+
+// systemPortAttrTypes describes the object type of a `ports` entry.
+var systemPortAttrTypes = map[string]attr.Type{
+	"protocol":  types.StringType,
+	"from_port": types.Int64Type,
+	"to_port":   types.Int64Type,
+}
+
+// systemPortAPIModel is a port range as exchanged with the GoodAccess API.
+type systemPortAPIModel struct {
+	Protocol string `json:"protocol"`
+	FromPort int64  `json:"fromPort"`
+	ToPort   int64  `json:"toPort"`
+}
+
+// portsToAPI converts the `ports` set into its API representation.
+func portsToAPI(ctx context.Context, ports types.Set) ([]systemPortAPIModel, diag.Diagnostics) {
+	if ports.IsNull() || ports.IsUnknown() {
+		return nil, nil
+	}
+
+	var entries []SystemPortModel
+	diags := ports.ElementsAs(ctx, &entries, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	result := make([]systemPortAPIModel, 0, len(entries))
+	for _, e := range entries {
+		result = append(result, systemPortAPIModel{
+			Protocol: e.Protocol.ValueString(),
+			FromPort: e.FromPort.ValueInt64(),
+			ToPort:   e.ToPort.ValueInt64(),
+		})
+	}
+	return result, diags
+}
+
+// samePorts reports whether a and b hold the same port ranges, in any order.
+func samePorts(a, b []systemPortAPIModel) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	used := make([]bool, len(b))
+	for _, pa := range a {
+		found := false
+		for i, pb := range b {
+			if !used[i] && pa.FromPort == pb.FromPort && pa.ToPort == pb.ToPort && strings.EqualFold(pa.Protocol, pb.Protocol) {
+				used[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// portsFromAPI converts port ranges returned by the API into a `ports` set.
+func portsFromAPI(ctx context.Context, ports []systemPortAPIModel) (types.Set, diag.Diagnostics) {
+	entries := make([]SystemPortModel, 0, len(ports))
+	for _, p := range ports {
+		entries = append(entries, SystemPortModel{
+			Protocol: types.StringValue(p.Protocol),
+			FromPort: types.Int64Value(p.FromPort),
+			ToPort:   types.Int64Value(p.ToPort),
+		})
+	}
+	return types.SetValueFrom(ctx, types.ObjectType{AttrTypes: systemPortAttrTypes}, entries)
+}
+
+// systemPortsValidator makes sure a system defines its ports either with the
+// legacy `port`/`protocol` pair or with `ports` blocks, and that every range
+// in `ports` is valid.
+type systemPortsValidator struct{}
+
+var _ resource.ConfigValidator = systemPortsValidator{}
+
+func (v systemPortsValidator) Description(_ context.Context) string {
+	return "Either both port and protocol, or at least one ports block must be configured, but not both."
+}
+
+func (v systemPortsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v systemPortsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var port types.Int64
+	var protocol types.String
+	var ports types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("port"), &port)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("protocol"), &protocol)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ports"), &ports)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasLegacy := !port.IsNull() || !protocol.IsNull()
+	hasPorts := ports.IsUnknown() || (!ports.IsNull() && len(ports.Elements()) > 0)
+
+	switch {
+	case hasLegacy && hasPorts:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ports"),
+			"Conflicting Port Configuration",
+			"Use either port and protocol, or ports blocks, but not both.",
+		)
+		return
+	case !hasLegacy && !hasPorts:
+		resp.Diagnostics.AddError(
+			"Missing Port Configuration",
+			"A system needs either port and protocol, or at least one ports block.",
+		)
+		return
+	case hasLegacy && (port.IsNull() || protocol.IsNull()):
+		resp.Diagnostics.AddError(
+			"Incomplete Port Configuration",
+			"port and protocol must be set together.",
+		)
+		return
+	}
+
+	if !hasPorts || ports.IsUnknown() {
+		return
+	}
+
+	var entries []SystemPortModel
+	resp.Diagnostics.Append(ports.ElementsAs(ctx, &entries, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, e := range entries {
+		if e.FromPort.IsUnknown() || e.ToPort.IsUnknown() {
+			continue
+		}
+		if err := validatePortRange(e.FromPort.ValueInt64(), e.ToPort.ValueInt64()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("ports"), "Invalid Port Range", err.Error())
+		}
+	}
+}
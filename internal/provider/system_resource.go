@@ -7,17 +7,39 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"io"
 	"net/http"
-	"net/http/httputil"
+	"strconv"
+	"strings"
 )
 
 type SystemResource struct {
-	client *http.Client
-	token  string
+	client   *http.Client
+	token    string
+	defaults providerDefaults
+	readOnly bool
+}
+
+var (
+	_ resource.ResourceWithModifyPlan       = &SystemResource{}
+	_ resource.ResourceWithConfigValidators = &SystemResource{}
+	_ resource.ResourceWithUpgradeState     = &SystemResource{}
+	_ resource.ResourceWithIdentity         = &SystemResource{}
+	_ resource.ResourceWithImportState      = &SystemResource{}
+)
+
+// systemIdentityModel identifies a goodaccess_system.
+type systemIdentityModel struct {
+	ID types.String `tfsdk:"id"`
 }
 
 func NewSystemResource() resource.Resource {
@@ -38,27 +60,132 @@ func (r *SystemResource) Configure(ctx context.Context, req resource.ConfigureRe
 		return
 	}
 
-	if !ok || data.Token.IsNull() {
+	if !ok {
 		resp.Diagnostics.AddError("Configuration Error", "Failed to retrieve provider data model.")
 		return
 	}
+	r.readOnly = data.ReadOnly.ValueBool()
 
+	defaults, diags := newProviderDefaults(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	r.defaults = defaults
+
+	// Credentials that are not known until apply leave the resource
+	// without a client for now; read_only still applies to the plan.
+	if data.client == nil {
+		return
+	}
+	if data.Token.IsNull() {
+		resp.Diagnostics.AddError("Missing Credentials", "The provider's token, or client_id and client_secret, must be set to use GoodAccess resources and data sources.")
+		return
+	}
+
+	r.client = data.client
 	r.token = data.Token.ValueString()
 }
 
 func (r *SystemResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
-			"name":     schema.StringAttribute{Required: true},
-			"host":     schema.StringAttribute{Required: true},
-			"uri":      schema.StringAttribute{Required: true},
-			"port":     schema.StringAttribute{Required: true},
-			"protocol": schema.StringAttribute{Required: true},
+			"name": schema.StringAttribute{Required: true},
+			"host": schema.StringAttribute{
+				Optional:    true,
+				Description: "Hostname, IP address or domain of the system. Required when `address_type` is `host`, `ip` or `domain`. A `domain` may start with a `*.` wildcard label, which covers exactly one extra label: `*.corp.example.com` matches `app.corp.example.com` but not `corp.example.com`. International domain names are sent to GoodAccess in punycode.",
+			},
+			"address_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(addressTypeHost),
+				Description: "How the system is addressed: `host`, `ip`, `cidr`, `ip_range` or `domain`. Defaults to `host`.",
+			},
+			"cidr": schema.StringAttribute{
+				Optional:    true,
+				Description: "IPv4 or IPv6 CIDR block, e.g. `10.20.0.0/16`. Required when `address_type` is `cidr`.",
+			},
+			"ip_range": schema.StringAttribute{
+				Optional:    true,
+				Description: "IP address range written as `<first>-<last>`, e.g. `10.0.0.10-10.0.0.50`. Required when `address_type` is `ip_range`.",
+			},
+			"uri": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "URI users open to reach the system. Defaults to `<scheme>://<host>:<port>`, using https unless the host or protocol says otherwise.",
+				PlanModifiers: []planmodifier.String{uriDefaultModifier{}},
+			},
+			"port": schema.Int64Attribute{
+				Optional:   true,
+				Validators: []validator.Int64{portValidator{}},
+			},
+			"protocol": schema.StringAttribute{Optional: true},
 			"id":       schema.StringAttribute{Computed: true},
+			"adopt_existing": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Take over an existing system with the same name, host, port and protocol instead of creating a new one.",
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Refuse to delete this system while true. Defaults to the provider's `deletion_protection` setting.",
+			},
+			"tags": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Tags of the system, e.g. environment or owner. Merged with the provider's `default_tags` into `tags_all`.",
+			},
+			"tags_all": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "All tags of the system, including the provider's `default_tags` and `managed_by`. Sent to GoodAccess as labels.",
+			},
+			"force_detach": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Delete all access card ↔ system relations of this system before deleting it.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"ports": schema.SetNestedBlock{
+				Description: "Port ranges the system listens on. Conflicts with `port` and `protocol`.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"protocol":  schema.StringAttribute{Required: true},
+						"from_port": schema.Int64Attribute{Required: true},
+						"to_port":   schema.Int64Attribute{Required: true},
+					},
+				},
+			},
 		},
 	}
 }
 
+func (r *SystemResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "ID of the system.",
+			},
+		},
+	}
+}
+
+// ImportState imports a system by its ID, given either as the import ID or as
+// the `id` identity attribute.
+func (r *SystemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+	if r.defaults.deletionProtectionKnown {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), r.defaults.deletionProtection)...)
+	}
+}
+
+func (r *SystemResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		systemPortsValidator{},
+		systemURIValidator{},
+		systemAddressValidator{},
+	}
+}
+
 func (r *SystemResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data SystemModel
 	diags := req.Plan.Get(ctx, &data)
@@ -67,12 +194,29 @@ func (r *SystemResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	payload := map[string]string{
-		"name":     data.Name.ValueString(),
-		"host":     data.Host.ValueString(),
-		"uri":      data.Uri.ValueString(),
-		"port":     data.Port.ValueString(),
-		"protocol": data.Protocol.ValueString(),
+	payload, diags := systemPayload(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.AdoptExisting.ValueBool() {
+		existing, ok := r.findAdoptable(ctx, data, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if ok {
+			if err := updateSystem(r.client, r.token, existing.ID, payload); err != nil {
+				resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update adopted system %s: %s", existing.ID, err))
+				return
+			}
+
+			data.ID = types.StringValue(existing.ID)
+			diags = resp.State.Set(ctx, &data)
+			resp.Diagnostics.Append(diags...)
+			resp.Diagnostics.Append(resp.Identity.Set(ctx, systemIdentityModel{ID: data.ID})...)
+			return
+		}
 	}
 
 	body, err := json.Marshal(payload)
@@ -89,13 +233,6 @@ func (r *SystemResource) Create(ctx context.Context, req resource.CreateRequest,
 	httpReq.Header.Add("Authorization", "Bearer "+r.token)
 	httpReq.Header.Add("Content-Type", "application/json")
 
-	dump, err := httputil.DumpRequestOut(httpReq, true)
-	if err != nil {
-		fmt.Printf("DEBUG: Could not dump request: %s\n", err)
-	} else {
-		fmt.Printf("DEBUG: Outgoing HTTP request:\n%s\n", dump)
-	}
-
 	httpResp, err := r.client.Do(httpReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Network Error", fmt.Sprintf("Could not send request: %s", err))
@@ -104,7 +241,6 @@ func (r *SystemResource) Create(ctx context.Context, req resource.CreateRequest,
 	defer httpResp.Body.Close()
 
 	bodyBytes, _ := io.ReadAll(httpResp.Body)
-	fmt.Printf("DEBUG: response: %s\n", bodyBytes)
 	if httpResp.StatusCode != http.StatusOK {
 		// Try to extract API error message
 		var errResp map[string]interface{}
@@ -131,6 +267,114 @@ func (r *SystemResource) Create(ctx context.Context, req resource.CreateRequest,
 	data.ID = types.StringValue(result.CreatedID)
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, systemIdentityModel{ID: data.ID})...)
+}
+
+// ModifyPlan rejects names that are already taken by another system so the
+// conflict is reported at plan time rather than halfway through apply.
+func (r *SystemResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Checked last, against the plan as modified below.
+	defer rejectChangesIfReadOnly(r.readOnly, req, resp)
+
+	// Nothing to check on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan SystemModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planProviderDefaults(ctx, r.defaults, req, resp)
+
+	// The remaining checks need the API.
+	if resp.Diagnostics.HasError() || r.token == "" {
+		return
+	}
+
+	var state SystemModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if plan.Name.IsUnknown() || plan.Name.Equal(state.Name) {
+		return
+	}
+	if plan.AdoptExisting.ValueBool() && (plan.address().IsUnknown() || plan.Port.IsUnknown() || plan.Protocol.IsUnknown() || plan.Ports.IsUnknown()) {
+		return
+	}
+
+	ports, diags := portsToAPI(ctx, plan.Ports)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	systems, err := fetchSystems(r.client, r.token)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Name Check Skipped", fmt.Sprintf("Could not list systems to check for name collisions: %s", err))
+		return
+	}
+
+	for _, s := range systems {
+		if s.Name != plan.Name.ValueString() || s.ID == state.ID.ValueString() {
+			continue
+		}
+		// Adoption takes over an exact match instead of colliding with it.
+		if state.ID.IsNull() && plan.AdoptExisting.ValueBool() && s.adoptableFor(plan, ports) {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Duplicate System Name",
+			fmt.Sprintf("A system named %q already exists (ID %s). Choose a different name, import it, or set adopt_existing.", s.Name, s.ID),
+		)
+		return
+	}
+}
+
+// findAdoptable looks for an existing system with the same name, host, port
+// and protocol or port ranges as the plan. More than one match is reported
+// as an error because there is no safe way to pick one.
+func (r *SystemResource) findAdoptable(ctx context.Context, data SystemModel, diags *diag.Diagnostics) (systemAPIModel, bool) {
+	ports, d := portsToAPI(ctx, data.Ports)
+	diags.Append(d...)
+	if diags.HasError() {
+		return systemAPIModel{}, false
+	}
+
+	systems, err := fetchSystems(r.client, r.token)
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Failed to look up existing systems: %s", err))
+		return systemAPIModel{}, false
+	}
+
+	var matches []systemAPIModel
+	for _, s := range systems {
+		if s.Name == data.Name.ValueString() && s.adoptableFor(data, ports) {
+			matches = append(matches, s)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return systemAPIModel{}, false
+	case 1:
+		return matches[0], true
+	default:
+		diags.AddError(
+			"Ambiguous Adoption",
+			fmt.Sprintf("Found %d existing systems named %q with host %q, port %d and protocol %q. Remove the duplicates or import the right one explicitly.",
+				len(matches), data.Name.ValueString(), data.address().ValueString(), data.Port.ValueInt64(), data.Protocol.ValueString()),
+		)
+		return systemAPIModel{}, false
+	}
 }
 
 func (r *SystemResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -147,6 +391,30 @@ func (r *SystemResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	if data.DeletionProtection.ValueBool() || (data.DeletionProtection.IsNull() && r.defaults.deletionProtection) {
+		resp.Diagnostics.AddError(
+			"Deletion Protection Enabled",
+			fmt.Sprintf("System %q (%s) has deletion_protection enabled. Set deletion_protection = false and apply before destroying it.", data.Name.ValueString(), id),
+		)
+		return
+	}
+
+	if data.ForceDetach.ValueBool() {
+		removed, err := detachRelations(r.client, r.token, func(rel relationAPIModel) bool {
+			return rel.SystemID == id
+		})
+		for _, rel := range removed {
+			resp.Diagnostics.AddWarning(
+				"Relation Detached",
+				fmt.Sprintf("Removed relation %s between access card %s and system %s.", rel.ID, rel.AccessCardID, rel.SystemID),
+			)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to detach relations from system %s: %s", id, err))
+			return
+		}
+	}
+
 	url := fmt.Sprintf("https://integration.goodaccess.com/api/v1/system/%s", id)
 
 	httpReq, err := http.NewRequest("DELETE", url, nil)
@@ -190,41 +458,9 @@ func (r *SystemResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	// Fetch all systems
-	url := "https://integration.goodaccess.com/api/v1/systems"
-	httpReq, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		resp.Diagnostics.AddError("Request Error", fmt.Sprintf("Failed to create request: %s", err))
-		return
-	}
-	httpReq.Header.Add("Authorization", "Bearer "+r.token)
-	httpReq.Header.Add("Accept", "*/*")
-
-	httpResp, err := r.client.Do(httpReq)
+	systems, err := fetchSystems(r.client, r.token)
 	if err != nil {
-		resp.Diagnostics.AddError("Network Error", fmt.Sprintf("GET request failed: %s", err))
-		return
-	}
-	defer httpResp.Body.Close()
-
-	if httpResp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(httpResp.Body)
-		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read system list (status %d): %s", httpResp.StatusCode, string(bodyBytes)))
-		return
-	}
-
-	// Parse the response
-	var systems []struct {
-		ID       string `json:"id"`
-		Name     string `json:"name"`
-		Host     string `json:"host"`
-		Uri      string `json:"uri"`
-		Port     string `json:"port"`
-		Protocol string `json:"protocol"` // optional, API may or may not send it
-	}
-	bodyBytes, _ := io.ReadAll(httpResp.Body)
-	if err := json.Unmarshal(bodyBytes, &systems); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Failed to parse response: %s", err))
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read system list: %s", err))
 		return
 	}
 
@@ -232,12 +468,7 @@ func (r *SystemResource) Read(ctx context.Context, req resource.ReadRequest, res
 	var found bool
 	for _, s := range systems {
 		if s.ID == id {
-			state.ID = types.StringValue(s.ID)
-			state.Name = types.StringValue(s.Name)
-			state.Host = types.StringValue(s.Host)
-			state.Uri = types.StringValue(s.Uri)
-			state.Port = types.StringValue(s.Port)
-			state.Protocol = types.StringValue(s.Protocol) // optional: handle "" if needed
+			resp.Diagnostics.Append(state.fromAPI(ctx, s, r.defaults.tags)...)
 			found = true
 			break
 		}
@@ -248,8 +479,15 @@ func (r *SystemResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	// State from before deletion_protection existed takes the provider
+	// default, as ModifyPlan would, so it does not plan an update.
+	if state.DeletionProtection.IsNull() && r.defaults.deletionProtectionKnown {
+		state.DeletionProtection = types.BoolValue(r.defaults.deletionProtection)
+	}
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, systemIdentityModel{ID: state.ID})...)
 }
 
 func (r *SystemResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -274,62 +512,224 @@ func (r *SystemResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	url := fmt.Sprintf("https://integration.goodaccess.com/api/v1/system/%s", id)
-
 	// Construct request payload from the planned state
-	payload := map[string]string{
-		"name":     plan.Name.ValueString(),
-		"host":     plan.Host.ValueString(),
-		"uri":      plan.Uri.ValueString(),
-		"port":     plan.Port.ValueString(),
-		"protocol": plan.Protocol.ValueString(),
+	payload, diags := systemPayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := updateSystem(r.client, r.token, id, payload); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update system: %s", err))
+		return
+	}
+
+	// Save the updated state
+	plan.ID = state.ID // preserve ID in state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, systemIdentityModel{ID: plan.ID})...)
+}
+
+type SystemModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Host               types.String `tfsdk:"host"`
+	AddressType        types.String `tfsdk:"address_type"`
+	Cidr               types.String `tfsdk:"cidr"`
+	IPRange            types.String `tfsdk:"ip_range"`
+	Uri                types.String `tfsdk:"uri"`
+	Port               types.Int64  `tfsdk:"port"`
+	Protocol           types.String `tfsdk:"protocol"`
+	AdoptExisting      types.Bool   `tfsdk:"adopt_existing"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	ForceDetach        types.Bool   `tfsdk:"force_detach"`
+	Ports              types.Set    `tfsdk:"ports"`
+	Tags               types.Map    `tfsdk:"tags"`
+	TagsAll            types.Map    `tfsdk:"tags_all"`
+}
+
+// systemAPIModel is a system as returned by the GoodAccess /systems endpoint.
+type systemAPIModel struct {
+	ID          string               `json:"id"`
+	Name        string               `json:"name"`
+	Host        string               `json:"host"`
+	AddressType string               `json:"addressType,omitempty"` // one of addressTypes, the address itself is sent as host
+	Uri         string               `json:"uri"`
+	Port        apiPort              `json:"port"`
+	Protocol    string               `json:"protocol"` // optional, API may or may not send it
+	Ports       []systemPortAPIModel `json:"ports,omitempty"`
+	Labels      map[string]string    `json:"labels,omitempty"`
+}
+
+// fromAPI copies a system returned by the API into m. Attributes that only
+// exist in the configuration, like adopt_existing, are left alone.
+func (m *SystemModel) fromAPI(ctx context.Context, s systemAPIModel, defaultTags map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(s.ID)
+	m.Name = types.StringValue(s.Name)
+	m.setAddress(s)
+	var d diag.Diagnostics
+	m.Tags, m.TagsAll, d = tagsFromAPI(ctx, s.Labels, defaultTags, m.Tags)
+	diags.Append(d...)
+	m.Uri = types.StringValue(s.Uri)
+	if len(s.Ports) > 0 {
+		ports, d := portsFromAPI(ctx, s.Ports)
+		diags.Append(d...)
+		m.Ports = ports
+	} else {
+		port, err := parsePort(string(s.Port))
+		if err != nil {
+			diags.AddWarning("Unexpected Port", fmt.Sprintf("System %s has a port that cannot be represented as a number: %s", s.ID, err))
+		}
+		m.Port = port
+		m.Protocol = types.StringValue(s.Protocol) // optional: handle "" if needed
+		if m.Ports.IsNull() {
+			m.Ports = types.SetValueMust(types.ObjectType{AttrTypes: systemPortAttrTypes}, nil)
+		}
+	}
+	return diags
+}
+
+// addressType returns the address type of s; systems created before address
+// types existed are plain hosts.
+func (s systemAPIModel) addressType() string {
+	if s.AddressType == "" {
+		return addressTypeHost
+	}
+	return s.AddressType
+}
+
+// address returns the attribute that holds the system address for its
+// address_type.
+func (m SystemModel) address() types.String {
+	switch m.AddressType.ValueString() {
+	case addressTypeCIDR:
+		return m.Cidr
+	case addressTypeIPRange:
+		return m.IPRange
+	}
+	return m.Host
+}
+
+// setAddress copies the address returned by the API into the attribute that
+// matches its type, keeping the prior value if it is only written differently.
+func (m *SystemModel) setAddress(s systemAPIModel) {
+	kind := s.addressType()
+	m.AddressType = types.StringValue(kind)
+
+	value := types.StringValue(s.Host)
+	if prior := m.address(); !prior.IsNull() && sameAddress(kind, prior.ValueString(), s.Host) {
+		value = prior
+	}
+
+	m.Host, m.Cidr, m.IPRange = types.StringNull(), types.StringNull(), types.StringNull()
+	switch kind {
+	case addressTypeCIDR:
+		m.Cidr = value
+	case addressTypeIPRange:
+		m.IPRange = value
+	default:
+		m.Host = value
+	}
+}
+
+// adoptableFor reports whether s can be taken over by a system planned as
+// data, i.e. it has the same address, port and protocol, and the same port
+// ranges as ports, the API form of data's `ports` blocks.
+func (s systemAPIModel) adoptableFor(data SystemModel, ports []systemPortAPIModel) bool {
+	kind := s.addressType()
+	return kind == data.AddressType.ValueString() &&
+		sameAddress(kind, s.Host, data.address().ValueString()) &&
+		s.Port.matches(data.Port) &&
+		strings.EqualFold(s.Protocol, data.Protocol.ValueString()) &&
+		samePorts(s.Ports, ports)
+}
+
+// systemPayload builds the create/update request body for a system. Systems
+// configured with `ports` blocks send the ranges instead of port/protocol.
+func systemPayload(ctx context.Context, data SystemModel) (map[string]interface{}, diag.Diagnostics) {
+	host := data.address().ValueString()
+	if data.AddressType.ValueString() == addressTypeDomain {
+		if ascii, err := normalizeDomain(host); err == nil {
+			host = ascii
+		}
+	}
+
+	payload := map[string]interface{}{
+		"name":        data.Name.ValueString(),
+		"host":        host,
+		"addressType": data.AddressType.ValueString(),
+		"uri":         data.Uri.ValueString(),
+	}
+
+	labels, diags := tagsToAPI(ctx, data.TagsAll)
+	payload["labels"] = labels
+
+	ports, d := portsToAPI(ctx, data.Ports)
+	diags.Append(d...)
+	if len(ports) > 0 {
+		payload["ports"] = ports
+	} else {
+		payload["port"] = strconv.FormatInt(data.Port.ValueInt64(), 10)
+		payload["protocol"] = data.Protocol.ValueString()
+	}
+	return payload, diags
+}
+
+// fetchSystems lists all systems visible to the token.
+func fetchSystems(client *http.Client, token string) ([]systemAPIModel, error) {
+	httpReq, err := http.NewRequest("GET", "https://integration.goodaccess.com/api/v1/systems", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Add("Authorization", "Bearer "+token)
+	httpReq.Header.Add("Accept", "*/*")
+
+	httpResp, err := client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("GET request failed: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	bodyBytes, _ := io.ReadAll(httpResp.Body)
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("status %d: %s", httpResp.StatusCode, string(bodyBytes))
 	}
 
+	var systems []systemAPIModel
+	if err := json.Unmarshal(bodyBytes, &systems); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	return systems, nil
+}
+
+// updateSystem replaces the attributes of an existing system.
+func updateSystem(client *http.Client, token, id string, payload map[string]interface{}) error {
 	body, err := json.Marshal(payload)
 	if err != nil {
-		resp.Diagnostics.AddError("JSON Error", fmt.Sprintf("Failed to encode update payload: %s", err))
-		return
+		return fmt.Errorf("failed to encode update payload: %w", err)
 	}
 
-	// Prepare the HTTP PUT request
+	url := fmt.Sprintf("https://integration.goodaccess.com/api/v1/system/%s", id)
 	httpReq, err := http.NewRequest("PUT", url, bytes.NewBuffer(body))
 	if err != nil {
-		resp.Diagnostics.AddError("Request Error", fmt.Sprintf("Failed to create update request: %s", err))
-		return
+		return fmt.Errorf("failed to create update request: %w", err)
 	}
-	httpReq.Header.Add("Authorization", "Bearer "+r.token)
+	httpReq.Header.Add("Authorization", "Bearer "+token)
 	httpReq.Header.Add("Content-Type", "application/json")
 	httpReq.Header.Add("Accept", "*/*")
 
-	// Send the request
-	httpResp, err := r.client.Do(httpReq)
+	httpResp, err := client.Do(httpReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Network Error", fmt.Sprintf("Update request failed: %s", err))
-		return
+		return fmt.Errorf("update request failed: %w", err)
 	}
 	defer httpResp.Body.Close()
 
-	// Check API response
 	if httpResp.StatusCode != http.StatusOK {
 		bodyBytes, _ := io.ReadAll(httpResp.Body)
-		resp.Diagnostics.AddError(
-			"API Error",
-			fmt.Sprintf("Update failed with status %d: %s", httpResp.StatusCode, string(bodyBytes)),
-		)
-		return
+		return fmt.Errorf("update failed with status %d: %s", httpResp.StatusCode, string(bodyBytes))
 	}
-
-	// Save the updated state
-	plan.ID = state.ID // preserve ID in state
-	diags = resp.State.Set(ctx, &plan)
-	resp.Diagnostics.Append(diags...)
-}
-
-type SystemModel struct {
-	ID       types.String `tfsdk:"id"`
-	Name     types.String `tfsdk:"name"`
-	Host     types.String `tfsdk:"host"`
-	Uri      types.String `tfsdk:"uri"`
-	Port     types.String `tfsdk:"port"`
-	Protocol types.String `tfsdk:"protocol"`
+	return nil
 }
@@ -0,0 +1,87 @@
+// Copyright (c) KRUKON s.r.o
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// systemModelV0 is goodaccess_system state from before `port` became a number.
+type systemModelV0 struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Host     types.String `tfsdk:"host"`
+	Uri      types.String `tfsdk:"uri"`
+	Port     types.String `tfsdk:"port"`
+	Protocol types.String `tfsdk:"protocol"`
+}
+
+// systemSchemaV0 is the goodaccess_system schema of the last release that
+// stored `port` as a string.
+var systemSchemaV0 = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"name":     schema.StringAttribute{Required: true},
+		"host":     schema.StringAttribute{Required: true},
+		"uri":      schema.StringAttribute{Required: true},
+		"port":     schema.StringAttribute{Required: true},
+		"protocol": schema.StringAttribute{Required: true},
+		"id":       schema.StringAttribute{Computed: true},
+	},
+}
+
+func (r *SystemResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &systemSchemaV0,
+			StateUpgrader: r.upgradeStateV0,
+		},
+	}
+}
+
+// upgradeStateV0 converts the string `port` of version 0 into a number and
+// fills in the attributes added since with the values the next plan expects.
+func (r *SystemResource) upgradeStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior systemModelV0
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	port, err := parsePort(prior.Port.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("port"),
+			"State Upgrade Error",
+			fmt.Sprintf("Could not convert port of system %s to a number: %s", prior.ID.ValueString(), err),
+		)
+		return
+	}
+
+	upgraded := SystemModel{
+		ID:                 prior.ID,
+		Name:               prior.Name,
+		Host:               prior.Host,
+		AddressType:        types.StringValue(addressTypeHost),
+		Uri:                prior.Uri,
+		Port:               port,
+		Protocol:           prior.Protocol,
+		AdoptExisting:      types.BoolNull(),
+		DeletionProtection: types.BoolNull(),
+		ForceDetach:        types.BoolNull(),
+		Ports:              types.SetValueMust(types.ObjectType{AttrTypes: systemPortAttrTypes}, nil),
+		Tags:               types.MapNull(types.StringType),
+		TagsAll:            types.MapValueMust(types.StringType, map[string]attr.Value{}),
+	}
+	// A provider default that is not known yet is left to the next plan.
+	if r.defaults.deletionProtectionKnown {
+		upgraded.DeletionProtection = types.BoolValue(r.defaults.deletionProtection)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, upgraded)...)
+}
@@ -0,0 +1,94 @@
+// Copyright (c) KRUKON s.r.o
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"testing"
+)
+
+func TestUpgradeSystemStateV0(t *testing.T) {
+	ctx := context.Background()
+
+	var current resource.SchemaResponse
+	(&SystemResource{}).Schema(ctx, resource.SchemaRequest{}, &current)
+
+	tests := []struct {
+		name               string
+		port               string
+		deletionProtection bool
+		wantPort           types.Int64
+		wantErr            bool
+	}{
+		{name: "number", port: "8080", wantPort: types.Int64Value(8080)},
+		{name: "padded number", port: " 443 ", deletionProtection: true, wantPort: types.Int64Value(443)},
+		{name: "empty", port: "", wantPort: types.Int64Null()},
+		{name: "not a number", port: "http", wantErr: true},
+		{name: "out of range", port: "70000", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			priorType := systemSchemaV0.Type().TerraformType(ctx)
+			req := resource.UpgradeStateRequest{
+				State: &tfsdk.State{
+					Schema: systemSchemaV0,
+					Raw: tftypes.NewValue(priorType, map[string]tftypes.Value{
+						"id":       tftypes.NewValue(tftypes.String, "sys-1"),
+						"name":     tftypes.NewValue(tftypes.String, "web"),
+						"host":     tftypes.NewValue(tftypes.String, "10.0.0.1"),
+						"uri":      tftypes.NewValue(tftypes.String, "https://10.0.0.1"),
+						"port":     tftypes.NewValue(tftypes.String, tt.port),
+						"protocol": tftypes.NewValue(tftypes.String, "TCP"),
+					}),
+				},
+			}
+			resp := resource.UpgradeStateResponse{
+				State: tfsdk.State{
+					Schema: current.Schema,
+					Raw:    tftypes.NewValue(current.Schema.Type().TerraformType(ctx), nil),
+				},
+			}
+
+			r := &SystemResource{defaults: providerDefaults{deletionProtection: tt.deletionProtection, deletionProtectionKnown: true}}
+			r.upgradeStateV0(ctx, req, &resp)
+
+			if tt.wantErr {
+				if !resp.Diagnostics.HasError() {
+					t.Fatalf("expected an error for port %q", tt.port)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var got SystemModel
+			if diags := resp.State.Get(ctx, &got); diags.HasError() {
+				t.Fatalf("reading upgraded state: %v", diags)
+			}
+			if !got.Port.Equal(tt.wantPort) {
+				t.Errorf("port = %s, want %s", got.Port, tt.wantPort)
+			}
+			if got.ID.ValueString() != "sys-1" || got.Protocol.ValueString() != "TCP" || got.Uri.ValueString() != "https://10.0.0.1" {
+				t.Errorf("prior attributes not kept: %+v", got)
+			}
+			if got.AddressType.ValueString() != addressTypeHost {
+				t.Errorf("address_type = %s, want %s", got.AddressType, addressTypeHost)
+			}
+			if !got.DeletionProtection.Equal(types.BoolValue(tt.deletionProtection)) {
+				t.Errorf("deletion_protection = %s, want provider default %t", got.DeletionProtection, tt.deletionProtection)
+			}
+			if got.TagsAll.IsNull() || len(got.TagsAll.Elements()) != 0 {
+				t.Errorf("tags_all = %s, want an empty map", got.TagsAll)
+			}
+			if got.Ports.IsNull() || len(got.Ports.Elements()) != 0 {
+				t.Errorf("ports = %s, want an empty set", got.Ports)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright (c) KRUKON s.r.o
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// splitHost separates an optional scheme from a system host, so both
+// "example.com" and "https://example.com" are accepted.
+func splitHost(host string) (scheme, hostname string) {
+	if u, err := url.Parse(host); err == nil && u.Scheme != "" && u.Host != "" {
+		return u.Scheme, u.Hostname()
+	}
+	return "", host
+}
+
+// deriveURI builds the default uri of a system from its host, port and
+// protocol, e.g. "https://example.com:8443". The scheme comes from the host
+// if it has one, then from an HTTP(S) protocol, and is https otherwise.
+func deriveURI(host string, port types.Int64, protocol string) string {
+	scheme, hostname := splitHost(host)
+	if scheme == "" {
+		switch p := strings.ToLower(protocol); p {
+		case "http", "https":
+			scheme = p
+		default:
+			scheme = "https"
+		}
+	}
+
+	if port.IsNull() {
+		return fmt.Sprintf("%s://%s", scheme, hostname)
+	}
+	return fmt.Sprintf("%s://%s", scheme, net.JoinHostPort(hostname, strconv.FormatInt(port.ValueInt64(), 10)))
+}
+
+// uriPort returns the explicit port of u, or the default port of its scheme.
+func uriPort(u *url.URL) (int64, bool) {
+	if p := u.Port(); p != "" {
+		n, err := strconv.ParseInt(p, 10, 64)
+		return n, err == nil
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "http":
+		return 80, true
+	case "https":
+		return 443, true
+	}
+	return 0, false
+}
+
+// uriDefaultModifier fills in `uri` from host, port and protocol when it is
+// not configured. While those are unchanged the value in state is kept, as
+// the API may store the URI in a normalised form.
+type uriDefaultModifier struct{}
+
+var _ planmodifier.String = uriDefaultModifier{}
+
+func (m uriDefaultModifier) Description(_ context.Context) string {
+	return "Defaults to <scheme>://<host>:<port> derived from host, port and protocol."
+}
+
+func (m uriDefaultModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m uriDefaultModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Nothing to derive on destroy or when the user set a uri.
+	if req.Plan.Raw.IsNull() || !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var host, protocol types.String
+	var port types.Int64
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("host"), &host)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("port"), &port)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("protocol"), &protocol)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if host.IsUnknown() || port.IsUnknown() || protocol.IsUnknown() {
+		resp.PlanValue = types.StringUnknown()
+		return
+	}
+
+	if !req.State.Raw.IsNull() && !req.StateValue.IsNull() {
+		var stateHost, stateProtocol types.String
+		var statePort types.Int64
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("host"), &stateHost)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("port"), &statePort)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("protocol"), &stateProtocol)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if host.Equal(stateHost) && port.Equal(statePort) && protocol.Equal(stateProtocol) {
+			resp.PlanValue = req.StateValue
+			return
+		}
+	}
+	// Address ranges, CIDR blocks and wildcard domains have no single URI.
+	if host.IsNull() || strings.HasPrefix(host.ValueString(), "*.") {
+		resp.PlanValue = types.StringValue("")
+		return
+	}
+
+	resp.PlanValue = types.StringValue(deriveURI(host.ValueString(), port, protocol.ValueString()))
+}
+
+// systemURIValidator warns when an explicit uri points to a different host or
+// port than the system itself, which is almost always a copy-paste mistake.
+type systemURIValidator struct{}
+
+var _ resource.ConfigValidator = systemURIValidator{}
+
+func (v systemURIValidator) Description(_ context.Context) string {
+	return "uri should point to the same host and port as the system."
+}
+
+func (v systemURIValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v systemURIValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var uri, host types.String
+	var port types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("uri"), &uri)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("host"), &host)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("port"), &port)...)
+	if resp.Diagnostics.HasError() || uri.IsNull() || uri.IsUnknown() {
+		return
+	}
+
+	u, err := url.Parse(uri.ValueString())
+	if err != nil || u.Host == "" {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("uri"),
+			"Unparsable URI",
+			fmt.Sprintf("Could not parse %q as an absolute URI, so it was not compared with host and port.", uri.ValueString()),
+		)
+		return
+	}
+
+	if !host.IsNull() && !host.IsUnknown() {
+		_, hostname := splitHost(host.ValueString())
+		if !domainMatches(hostname, u.Hostname()) {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("uri"),
+				"URI Does Not Match Host",
+				fmt.Sprintf("uri %q points to host %q, but the system host is %q.", uri.ValueString(), u.Hostname(), hostname),
+			)
+		}
+	}
+
+	if !port.IsNull() && !port.IsUnknown() {
+		if p, ok := uriPort(u); ok && p != port.ValueInt64() {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("uri"),
+				"URI Does Not Match Port",
+				fmt.Sprintf("uri %q uses port %d, but the system port is %d.", uri.ValueString(), p, port.ValueInt64()),
+			)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright (c) KRUKON s.r.o
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/http"
+)
+
+type SystemsDataSourceModel struct {
+	Name    types.String                 `tfsdk:"name"`
+	Tags    types.Map                    `tfsdk:"tags"`
+	IDs     []types.String               `tfsdk:"ids"`
+	Systems []SystemsDataSourceItemModel `tfsdk:"systems"`
+}
+
+type SystemsDataSourceItemModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	AddressType types.String `tfsdk:"address_type"`
+	Host        types.String `tfsdk:"host"`
+	Uri         types.String `tfsdk:"uri"`
+	Port        types.Int64  `tfsdk:"port"`
+	Protocol    types.String `tfsdk:"protocol"`
+	Tags        types.Map    `tfsdk:"tags"`
+}
+
+func NewSystemsDataSource() datasource.DataSource {
+	return &SystemsDataSource{
+		client: &http.Client{},
+		token:  "",
+	}
+}
+
+type SystemsDataSource struct {
+	client *http.Client
+	token  string
+}
+
+func (d *SystemsDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "goodaccess_systems"
+}
+
+func (d *SystemsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	data, ok := req.ProviderData.(goodAccessProviderModel)
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	if !ok {
+		resp.Diagnostics.AddError("Configuration Error", "Failed to retrieve provider data model.")
+		return
+	}
+	if data.Token.IsNull() {
+		resp.Diagnostics.AddError("Missing Credentials", "The provider's token, or client_id and client_secret, must be set to use GoodAccess resources and data sources.")
+		return
+	}
+
+	d.client = data.client
+	d.token = data.Token.ValueString()
+}
+
+func (d *SystemsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists GoodAccess systems, optionally filtered by name and tags.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return systems with exactly this name.",
+			},
+			"tags": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Only return systems that have all of these tags.",
+			},
+			"ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "IDs of the matching systems.",
+			},
+			"systems": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching systems.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":           schema.StringAttribute{Computed: true},
+						"name":         schema.StringAttribute{Computed: true},
+						"address_type": schema.StringAttribute{Computed: true},
+						"host":         schema.StringAttribute{Computed: true},
+						"uri":          schema.StringAttribute{Computed: true},
+						"port":         schema.Int64Attribute{Computed: true},
+						"protocol":     schema.StringAttribute{Computed: true},
+						"tags":         schema.MapAttribute{ElementType: types.StringType, Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SystemsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SystemsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := map[string]string{}
+	if !data.Tags.IsNull() {
+		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &filter, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	systems, err := fetchSystems(d.client, d.token)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read system list: %s", err))
+		return
+	}
+
+	data.IDs = []types.String{}
+	data.Systems = []SystemsDataSourceItemModel{}
+	for _, s := range systems {
+		if !data.Name.IsNull() && s.Name != data.Name.ValueString() {
+			continue
+		}
+		if !tagsMatch(s.Labels, filter) {
+			continue
+		}
+
+		port, _ := parsePort(string(s.Port))
+		tags, diags := types.MapValueFrom(ctx, types.StringType, s.Labels)
+		resp.Diagnostics.Append(diags...)
+
+		data.IDs = append(data.IDs, types.StringValue(s.ID))
+		data.Systems = append(data.Systems, SystemsDataSourceItemModel{
+			ID:          types.StringValue(s.ID),
+			Name:        types.StringValue(s.Name),
+			AddressType: types.StringValue(s.addressType()),
+			Host:        types.StringValue(s.Host),
+			Uri:         types.StringValue(s.Uri),
+			Port:        port,
+			Protocol:    types.StringValue(s.Protocol),
+			Tags:        tags,
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,112 @@
+// Copyright (c) KRUKON s.r.o
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// managedByTag is the tag key the provider's `managed_by` value is stored under.
+const managedByTag = "managed_by"
+
+// providerDefaultTags returns the tags the provider adds to every object it
+// manages: `default_tags` plus `managed_by`, if set. Values that are not
+// known yet are left out; see providerDefaultTagsKnown.
+func providerDefaultTags(ctx context.Context, data goodAccessProviderModel) (map[string]string, diag.Diagnostics) {
+	tags := map[string]string{}
+
+	var diags diag.Diagnostics
+	if !data.DefaultTags.IsNull() && providerDefaultTagsKnown(data) {
+		diags = data.DefaultTags.ElementsAs(ctx, &tags, false)
+	}
+	if !data.ManagedBy.IsNull() && !data.ManagedBy.IsUnknown() {
+		tags[managedByTag] = data.ManagedBy.ValueString()
+	}
+	return tags, diags
+}
+
+// tagsToAPI converts a `tags_all` value into the labels sent to the API.
+func tagsToAPI(ctx context.Context, tagsAll types.Map) (map[string]string, diag.Diagnostics) {
+	labels := map[string]string{}
+	if tagsAll.IsNull() || tagsAll.IsUnknown() {
+		return labels, nil
+	}
+	diags := tagsAll.ElementsAs(ctx, &labels, false)
+	return labels, diags
+}
+
+// mergeTags computes `tags_all` from the provider's default tags and the
+// resource's own `tags`, which win on conflicting keys.
+func mergeTags(ctx context.Context, defaults map[string]string, tags types.Map) (types.Map, diag.Diagnostics) {
+	if tags.IsUnknown() {
+		return types.MapUnknown(types.StringType), nil
+	}
+
+	merged := make(map[string]string, len(defaults))
+	for k, v := range defaults {
+		merged[k] = v
+	}
+
+	var diags diag.Diagnostics
+	if !tags.IsNull() {
+		own := map[string]string{}
+		diags = tags.ElementsAs(ctx, &own, false)
+		for k, v := range own {
+			merged[k] = v
+		}
+	}
+
+	all, d := types.MapValueFrom(ctx, types.StringType, merged)
+	diags.Append(d...)
+	return all, diags
+}
+
+// tagsFromAPI splits the labels read from the API into `tags` and `tags_all`.
+// Labels that only come from the provider's default tags are left out of
+// `tags` unless the prior `tags` already had them, so defaults do not show up
+// as drift. Objects without labels get an empty `tags_all`, which is what
+// mergeTags plans for them.
+func tagsFromAPI(ctx context.Context, labels, defaults map[string]string, prior types.Map) (tags, tagsAll types.Map, diags diag.Diagnostics) {
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
+	own := map[string]string{}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		diags = prior.ElementsAs(ctx, &own, false)
+	}
+
+	result := map[string]string{}
+	for k, v := range labels {
+		if _, configured := own[k]; configured {
+			result[k] = v
+			continue
+		}
+		if dv, isDefault := defaults[k]; !isDefault || dv != v {
+			result[k] = v
+		}
+	}
+
+	tags = prior
+	if len(result) > 0 || !prior.IsNull() {
+		var d diag.Diagnostics
+		tags, d = types.MapValueFrom(ctx, types.StringType, result)
+		diags.Append(d...)
+	}
+
+	tagsAll, d := types.MapValueFrom(ctx, types.StringType, labels)
+	diags.Append(d...)
+	return tags, tagsAll, diags
+}
+
+// tagsMatch reports whether labels contain every key/value pair in filter.
+func tagsMatch(labels, filter map[string]string) bool {
+	for k, v := range filter {
+		if labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,66 @@
+// Copyright (c) KRUKON s.r.o
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"io"
+	"net/http"
+)
+
+// tenantAPIModel is the GoodAccess tenant the credentials belong to.
+type tenantAPIModel struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// fetchTenant reads the tenant the token belongs to. As this is the first
+// call the provider makes, its diagnostics explain what is wrong with the
+// credentials rather than with the request.
+func fetchTenant(client *http.Client, token string) (*tenantAPIModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	httpReq, err := http.NewRequest("GET", "https://integration.goodaccess.com/api/v1/tenant", nil)
+	if err != nil {
+		diags.AddError("Request Error", fmt.Sprintf("Failed to create GET request: %s", err))
+		return nil, diags
+	}
+	httpReq.Header.Add("Authorization", "Bearer "+token)
+	httpReq.Header.Add("Accept", "*/*")
+
+	httpResp, err := client.Do(httpReq)
+	if err != nil {
+		diags.AddError("Network Error", fmt.Sprintf("Could not reach the GoodAccess API to check the credentials: %s", err))
+		return nil, diags
+	}
+	defer httpResp.Body.Close()
+
+	bodyBytes, _ := io.ReadAll(httpResp.Body)
+	switch httpResp.StatusCode {
+	case http.StatusOK:
+	case http.StatusUnauthorized:
+		diags.AddError(
+			"Invalid Credentials",
+			"GoodAccess rejected the token: it is invalid or has expired. Issue a new API token, or check client_id and client_secret.",
+		)
+		return nil, diags
+	case http.StatusForbidden:
+		diags.AddError(
+			"Missing Permissions",
+			fmt.Sprintf("The token is valid but lacks the permissions the provider needs: %s", bodyBytes),
+		)
+		return nil, diags
+	default:
+		diags.AddError("API Error", fmt.Sprintf("Credential check failed: %d: %s", httpResp.StatusCode, bodyBytes))
+		return nil, diags
+	}
+
+	var tenant tenantAPIModel
+	if err := json.Unmarshal(bodyBytes, &tenant); err != nil {
+		diags.AddError("Parse Error", fmt.Sprintf("Failed to parse tenant: %s", err))
+		return nil, diags
+	}
+	return &tenant, diags
+}
@@ -0,0 +1,91 @@
+// Copyright (c) KRUKON s.r.o
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/http"
+)
+
+type TenantDataSourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func NewTenantDataSource() datasource.DataSource {
+	return &TenantDataSource{
+		client: &http.Client{},
+		token:  "",
+	}
+}
+
+type TenantDataSource struct {
+	client *http.Client
+	token  string
+	tenant *tenantAPIModel
+}
+
+func (d *TenantDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "goodaccess_tenant"
+}
+
+func (d *TenantDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	data, ok := req.ProviderData.(goodAccessProviderModel)
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	if !ok {
+		resp.Diagnostics.AddError("Configuration Error", "Failed to retrieve provider data model.")
+		return
+	}
+	if data.Token.IsNull() {
+		resp.Diagnostics.AddError("Missing Credentials", "The provider's token, or client_id and client_secret, must be set to use GoodAccess resources and data sources.")
+		return
+	}
+
+	d.client = data.client
+	d.token = data.Token.ValueString()
+	d.tenant = data.tenant
+}
+
+func (d *TenantDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The GoodAccess tenant the provider's credentials belong to.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the tenant.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the tenant.",
+			},
+		},
+	}
+}
+
+func (d *TenantDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// The provider already read the tenant unless skip_credentials_validation
+	// is set.
+	tenant := d.tenant
+	if tenant == nil {
+		var diags diag.Diagnostics
+		tenant, diags = fetchTenant(d.client, d.token)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	data := TenantDataSourceModel{
+		ID:   types.StringValue(tenant.ID),
+		Name: types.StringValue(tenant.Name),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,36 @@
+// Copyright (c) KRUKON s.r.o
+
+package provider
+
+import (
+	"context"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+	"net/http"
+)
+
+// defaultTokenURL is the GoodAccess OAuth2 token endpoint.
+const defaultTokenURL = "https://integration.goodaccess.com/api/v1/oauth/token"
+
+// tokenSource returns an OAuth2 token source that obtains access tokens with
+// a refresh token if one is given, and with the client credentials otherwise.
+// Token requests are sent through client.
+func tokenSource(ctx context.Context, client *http.Client, tokenURL, clientID, clientSecret, refreshToken string) oauth2.TokenSource {
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, client)
+
+	if refreshToken != "" {
+		config := &oauth2.Config{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			Endpoint:     oauth2.Endpoint{TokenURL: tokenURL},
+		}
+		return config.TokenSource(ctx, &oauth2.Token{RefreshToken: refreshToken})
+	}
+
+	config := &clientcredentials.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		TokenURL:     tokenURL,
+	}
+	return config.TokenSource(ctx)
+}
@@ -0,0 +1,153 @@
+// Copyright (c) KRUKON s.r.o
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/http"
+	"time"
+)
+
+type TokenEphemeralModel struct {
+	ClientID     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	RefreshToken types.String `tfsdk:"refresh_token"`
+	TokenURL     types.String `tfsdk:"token_url"`
+	AccessToken  types.String `tfsdk:"access_token"`
+	TokenType    types.String `tfsdk:"token_type"`
+	ExpiresAt    types.String `tfsdk:"expires_at"`
+}
+
+var (
+	_ ephemeral.EphemeralResourceWithValidateConfig = &TokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &TokenEphemeralResource{}
+)
+
+func NewTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &TokenEphemeralResource{
+		client: &http.Client{},
+	}
+}
+
+// TokenEphemeralResource exchanges long-lived credentials for a short-lived
+// GoodAccess API token that is never written to plan or state.
+type TokenEphemeralResource struct {
+	client *http.Client
+}
+
+func (r *TokenEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "goodaccess_token"
+}
+
+// Configure only takes over the provider's proxy and TLS settings; the
+// resource brings its own credentials.
+func (r *TokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	data, ok := req.ProviderData.(goodAccessProviderModel)
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	if !ok {
+		resp.Diagnostics.AddError("Configuration Error", "Failed to retrieve provider data model.")
+		return
+	}
+
+	r.client = &http.Client{Transport: data.transport}
+}
+
+func (r *TokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Obtains a short-lived GoodAccess API token from a client ID and secret or from a refresh token. The token is never stored in the plan or state; pass it to the provider's `token`.",
+		Attributes: map[string]schema.Attribute{
+			"client_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "OAuth2 client ID. Required unless `refresh_token` is set.",
+			},
+			"client_secret": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "OAuth2 client secret. Required with `client_id`.",
+			},
+			"refresh_token": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Refresh token to exchange for an access token instead of the client credentials.",
+			},
+			"token_url": schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("OAuth2 token endpoint. Defaults to `%s`.", defaultTokenURL),
+			},
+			"access_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The short-lived API token.",
+			},
+			"token_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Type of the token, usually `Bearer`.",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "RFC3339 timestamp at which the token expires, if the token endpoint reports it.",
+			},
+		},
+	}
+}
+
+func (r *TokenEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var data TokenEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case data.ClientID.IsUnknown() || data.ClientSecret.IsUnknown() || data.RefreshToken.IsUnknown():
+		return
+	case data.ClientID.IsNull() && data.RefreshToken.IsNull():
+		resp.Diagnostics.AddError(
+			"Missing Credentials",
+			"Either client_id and client_secret, or refresh_token must be set.",
+		)
+	case !data.ClientID.IsNull() && data.ClientSecret.IsNull() && data.RefreshToken.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_secret"),
+			"Missing Client Secret",
+			"client_secret is required when client_id is used without refresh_token.",
+		)
+	}
+}
+
+func (r *TokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data TokenEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokenURL := defaultTokenURL
+	if !data.TokenURL.IsNull() {
+		tokenURL = data.TokenURL.ValueString()
+	}
+
+	token, err := tokenSource(ctx, r.client, tokenURL, data.ClientID.ValueString(), data.ClientSecret.ValueString(), data.RefreshToken.ValueString()).Token()
+	if err != nil {
+		resp.Diagnostics.AddError("Authentication Error", fmt.Sprintf("Failed to obtain a token from %s: %s", tokenURL, err))
+		return
+	}
+
+	data.AccessToken = types.StringValue(token.AccessToken)
+	data.TokenType = types.StringValue(token.Type())
+	data.ExpiresAt = types.StringNull()
+	if !token.Expiry.IsZero() {
+		data.ExpiresAt = types.StringValue(token.Expiry.UTC().Format(time.RFC3339))
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
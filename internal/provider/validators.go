@@ -0,0 +1,99 @@
+// Copyright (c) KRUKON s.r.o
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"strings"
+	"time"
+)
+
+// attributesValidator checks how many of a group of top-level attributes are
+// set. With exactlyOne it requires exactly one of them, otherwise at most one.
+type attributesValidator struct {
+	names      []string
+	exactlyOne bool
+}
+
+var _ resource.ConfigValidator = attributesValidator{}
+
+// exactlyOneOf requires exactly one of the named attributes to be set.
+func exactlyOneOf(names ...string) attributesValidator {
+	return attributesValidator{names: names, exactlyOne: true}
+}
+
+// conflictsWith allows at most one of the named attributes to be set.
+func conflictsWith(names ...string) attributesValidator {
+	return attributesValidator{names: names}
+}
+
+func (v attributesValidator) Description(_ context.Context) string {
+	if v.exactlyOne {
+		return fmt.Sprintf("Exactly one of %s must be set.", strings.Join(v.names, ", "))
+	}
+	return fmt.Sprintf("Only one of %s can be set.", strings.Join(v.names, ", "))
+}
+
+func (v attributesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v attributesValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var set []string
+	unknown := false
+	for _, name := range v.names {
+		var value attr.Value
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &value)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		switch {
+		case value.IsUnknown():
+			// It may still turn out null, so only known values are counted.
+			unknown = true
+		case !value.IsNull():
+			set = append(set, name)
+		}
+	}
+
+	switch {
+	case len(set) > 1:
+		resp.Diagnostics.AddAttributeError(
+			path.Root(set[1]),
+			"Conflicting Attributes",
+			fmt.Sprintf("%s cannot be used together.", strings.Join(set, " and ")),
+		)
+	case len(set) == 0 && !unknown && v.exactlyOne:
+		resp.Diagnostics.AddError(
+			"Missing Attribute",
+			fmt.Sprintf("One of %s must be set.", strings.Join(v.names, ", ")),
+		)
+	}
+}
+
+// rfc3339Validator rejects timestamps that are not in RFC3339 format.
+type rfc3339Validator struct{}
+
+var _ validator.String = rfc3339Validator{}
+
+func (v rfc3339Validator) Description(_ context.Context) string {
+	return "value must be an RFC3339 timestamp, e.g. 2026-01-31T18:00:00Z"
+}
+
+func (v rfc3339Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v rfc3339Validator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Timestamp", fmt.Sprintf("%q is not an RFC3339 timestamp: %s", req.ConfigValue.ValueString(), err))
+	}
+}